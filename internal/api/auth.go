@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+type ctxKey int
+
+const localBypassKey ctxKey = iota
+
+// annotateLocalBypass is installed as http.Server.ConnContext on the
+// Unix-socket listener. It inspects the peer's credentials and marks the
+// context as auth-bypassed if the connecting process is running as root or
+// as the same user as this gt process - i.e. someone who could already
+// read every file this server would expose.
+func annotateLocalBypass(ctx context.Context, c net.Conn) context.Context {
+	uc, ok := c.(*net.UnixConn)
+	if !ok {
+		return ctx
+	}
+
+	raw, err := uc.SyscallConn()
+	if err != nil {
+		return ctx
+	}
+
+	var cred *unix.Ucred
+	raw.Control(func(fd uintptr) {
+		cred, err = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err != nil || cred == nil {
+		return ctx
+	}
+
+	if cred.Uid == 0 || int(cred.Uid) == os.Getuid() {
+		return context.WithValue(ctx, localBypassKey, true)
+	}
+	return ctx
+}
+
+func isLocalBypass(ctx context.Context) bool {
+	bypass, _ := ctx.Value(localBypassKey).(bool)
+	return bypass
+}
+
+// requireScope wraps next so it only runs once a request authenticates
+// with a bearer token carrying scope, or arrived over the Unix-socket
+// local-bypass path.
+func (s *Server) requireScope(scope string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if isLocalBypass(r.Context()) {
+			next(w, r)
+			return
+		}
+
+		if s.tokens == nil {
+			http.Error(w, "bearer token auth not configured", http.StatusServiceUnavailable)
+			return
+		}
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		info, err := s.tokens.Authenticate(token)
+		if err != nil {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		if !info.HasScope(scope) {
+			http.Error(w, "token missing required scope: "+scope, http.StatusForbidden)
+			return
+		}
+
+		next(w, r)
+	}
+}
@@ -0,0 +1,55 @@
+package api
+
+import "sync"
+
+// Event is a single sling/witness notification broadcast to /v1/events
+// subscribers.
+type Event struct {
+	Type    string `json:"type"` // "sling", "witness"
+	Agent   string `json:"agent,omitempty"`
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body,omitempty"`
+}
+
+// EventBus fans Event values out to any number of SSE subscribers. Slow or
+// gone subscribers never block a Publish - their channel is buffered and
+// publishes are dropped rather than blocking if it fills up.
+type EventBus struct {
+	mu   sync.Mutex
+	subs map[chan Event]struct{}
+}
+
+// NewEventBus creates an empty EventBus.
+func NewEventBus() *EventBus {
+	return &EventBus{subs: make(map[chan Event]struct{})}
+}
+
+// Subscribe registers a new subscriber, returning its channel and an
+// unsubscribe function the caller must call when done listening.
+func (b *EventBus) Subscribe() (ch chan Event, unsubscribe func()) {
+	ch = make(chan Event, 32)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs, ch)
+		b.mu.Unlock()
+		close(ch)
+	}
+}
+
+// Publish broadcasts event to every current subscriber.
+func (b *EventBus) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs {
+		select {
+		case ch <- event:
+		default:
+			// Subscriber isn't keeping up; drop rather than block Publish.
+		}
+	}
+}
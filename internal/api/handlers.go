@@ -0,0 +1,206 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+func (s *Server) routes() {
+	// /v1/sling authenticates and checks scope itself, since the required
+	// scope (sling:polecat vs sling:deacon) depends on the request body.
+	s.mux.HandleFunc("POST /v1/sling", s.handleSling)
+	s.mux.HandleFunc("GET /v1/hooks/{agent}", s.requireScope(ScopeReadMail, s.handleGetHook))
+	s.mux.HandleFunc("GET /v1/mailboxes/{agent}", s.requireScope(ScopeReadMail, s.handleGetMailbox))
+	s.mux.HandleFunc("POST /v1/mail/send", s.requireScope(ScopeWriteMail, s.handlePostMailSend))
+	s.mux.HandleFunc("GET /v1/events", s.requireScope(ScopeReadMail, s.handleEvents))
+}
+
+// handleSling dispatches a SlingRequest through the SlingFunc supplied at
+// construction - the same routing functions `gt sling` calls directly.
+func (s *Server) handleSling(w http.ResponseWriter, r *http.Request) {
+	var req SlingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("decoding request: %w", err))
+		return
+	}
+
+	requiredScope := ScopeSlingPolecat
+	if req.TargetKind == "deacon" {
+		requiredScope = ScopeSlingDeacon
+	}
+	if !isLocalBypass(r.Context()) {
+		if s.tokens == nil {
+			writeError(w, http.StatusServiceUnavailable, fmt.Errorf("bearer token auth not configured"))
+			return
+		}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		info, err := s.tokens.Authenticate(token)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, fmt.Errorf("invalid token"))
+			return
+		}
+		if !info.HasScope(requiredScope) {
+			writeError(w, http.StatusForbidden, fmt.Errorf("token missing required scope: %s", requiredScope))
+			return
+		}
+	}
+
+	result, err := s.sling(r.Context(), req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.events.Publish(Event{
+		Type:    "sling",
+		Agent:   fmt.Sprintf("%s/%s", req.TargetRig, req.TargetName),
+		Subject: fmt.Sprintf("SLING: %s", result.IssueID),
+	})
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleGetHook reports what's pinned to an agent's hook.
+func (s *Server) handleGetHook(w http.ResponseWriter, r *http.Request) {
+	agent := r.PathValue("agent")
+
+	info, err := s.hooks(agent)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+// mailboxRequest is the body POST /v1/mail/send and the query params
+// GET /v1/mailboxes/{agent} need to locate a mail.Router: mail is stored
+// per-rig, so callers must say which rig an agent address belongs to.
+type mailboxRequest struct {
+	RigPath string `json:"rig_path"`
+}
+
+// handleGetMailbox lists the messages in an agent's mailbox.
+func (s *Server) handleGetMailbox(w http.ResponseWriter, r *http.Request) {
+	agent := r.PathValue("agent")
+	rigPath := r.URL.Query().Get("rig_path")
+	if rigPath == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("rig_path query parameter is required"))
+		return
+	}
+	resolvedPath, err := s.resolveRigPath(rigPath)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	router := mail.NewRouter(resolvedPath)
+	mailbox, err := router.GetMailbox(agent)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	messages, err := mailbox.Messages()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, messages)
+}
+
+// sendMailRequest is the body for POST /v1/mail/send.
+type sendMailRequest struct {
+	mailboxRequest
+	mail.Message
+}
+
+// handlePostMailSend delivers a message through internal/mail.Router.
+func (s *Server) handlePostMailSend(w http.ResponseWriter, r *http.Request) {
+	var req sendMailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("decoding request: %w", err))
+		return
+	}
+	if req.RigPath == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("rig_path is required"))
+		return
+	}
+	resolvedPath, err := s.resolveRigPath(req.RigPath)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	router := mail.NewRouter(resolvedPath)
+	msg := req.Message
+	if err := router.Send(&msg); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	s.events.Publish(Event{Type: "witness", Agent: msg.To, Subject: msg.Subject, Body: msg.Body})
+	writeJSON(w, http.StatusOK, msg)
+}
+
+// handleEvents streams sling/witness notifications as Server-Sent Events.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming unsupported"))
+		return
+	}
+
+	ch, unsubscribe := s.events.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event := <-ch:
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			flusher.Flush()
+		}
+	}
+}
+
+// resolveRigPath joins a client-supplied rig_path onto the town root and
+// rejects anything that would resolve outside it (a ../ escape, or an
+// absolute path naming some other directory entirely) - rig_path reaches
+// here straight from the request, so it must never be trusted to stay
+// inside the town this server was configured for.
+func (s *Server) resolveRigPath(rigPath string) (string, error) {
+	full := filepath.Join(s.cfg.TownRoot, rigPath)
+	rel, err := filepath.Rel(s.cfg.TownRoot, full)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("rig_path %q is outside the town root", rigPath)
+	}
+	return full, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,151 @@
+// Package api exposes gt's sling/mail/hook operations over HTTP, so editor
+// plugins, CI bots, and dashboards can drive gastown programmatically
+// instead of shelling out to the gt binary.
+//
+// The package itself holds no gastown business logic - it's a thin
+// transport layer around SlingFunc/HookFunc callbacks supplied by the
+// caller (see internal/cmd/api.go), the same routing functions `gt sling`
+// uses directly, plus the internal/mail package for mailbox endpoints.
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Config controls how Server listens and what it authenticates against.
+type Config struct {
+	// Listen is the TCP address to serve on, e.g. "127.0.0.1:7777". Empty
+	// disables the TCP listener.
+	Listen string
+
+	// SocketPath is a Unix domain socket to additionally serve on. Empty
+	// disables it. Connections from the local root UID or the UID that
+	// owns SocketPath's process bypass bearer-token auth entirely.
+	SocketPath string
+
+	// TownRoot is the gas town this server operates against.
+	TownRoot string
+}
+
+// SlingRequest is the JSON representation of SlingThing+SlingTarget that
+// POST /v1/sling accepts - the same shape runSling builds internally.
+type SlingRequest struct {
+	ThingKind  string `json:"thing_kind"` // "proto", "issue", or "epic"
+	ThingID    string `json:"thing_id"`
+	Molecule   string `json:"molecule,omitempty"`
+	Wisp       bool   `json:"wisp,omitempty"`
+	TargetKind string `json:"target_kind"` // "polecat", "deacon", "witness", "refinery"
+	TargetRig  string `json:"target_rig"`
+	TargetName string `json:"target_name,omitempty"`
+	Priority   int    `json:"priority,omitempty"`
+	Force      bool   `json:"force,omitempty"`
+	NoStart    bool   `json:"no_start,omitempty"`
+	Create     bool   `json:"create,omitempty"`
+}
+
+// SlingResult is what POST /v1/sling returns on success.
+type SlingResult struct {
+	IssueID     string `json:"issue_id"`
+	RootIssueID string `json:"root_issue_id,omitempty"`
+	SessionName string `json:"session_name,omitempty"`
+}
+
+// SlingFunc dispatches a sling request the same way runSling does and is
+// supplied by the cmd package to avoid an import cycle back into it.
+type SlingFunc func(ctx context.Context, req SlingRequest) (SlingResult, error)
+
+// HookInfo describes what (if anything) is pinned to an agent's hook.
+type HookInfo struct {
+	Agent            string `json:"agent"`
+	AttachedMolecule string `json:"attached_molecule,omitempty"`
+}
+
+// HookFunc looks up an agent's hook state.
+type HookFunc func(agent string) (HookInfo, error)
+
+// Server serves the gastown HTTP API.
+type Server struct {
+	cfg    Config
+	tokens *TokenStore
+	sling  SlingFunc
+	hooks  HookFunc
+	events *EventBus
+
+	mux *http.ServeMux
+}
+
+// NewServer builds a Server. tokens may be nil if only the Unix-socket
+// transport (with its root/owner bypass) will be used.
+func NewServer(cfg Config, tokens *TokenStore, sling SlingFunc, hooks HookFunc) *Server {
+	s := &Server{
+		cfg:    cfg,
+		tokens: tokens,
+		sling:  sling,
+		hooks:  hooks,
+		events: NewEventBus(),
+	}
+	s.mux = http.NewServeMux()
+	s.routes()
+	return s
+}
+
+// Events returns the server's event bus, so callers outside the HTTP
+// handlers (e.g. a witness-notification hook) can publish to /v1/events too.
+func (s *Server) Events() *EventBus {
+	return s.events
+}
+
+// Handler returns the server's http.Handler, useful for tests that don't
+// want a real listener.
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// ListenAndServe runs the TCP and/or Unix-socket listeners configured in
+// cfg, blocking until ctx is cancelled or a listener errors.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	if s.cfg.Listen == "" && s.cfg.SocketPath == "" {
+		return fmt.Errorf("api: no listener configured (set Listen and/or SocketPath)")
+	}
+
+	errCh := make(chan error, 2)
+	var servers []*http.Server
+
+	if s.cfg.Listen != "" {
+		srv := &http.Server{Addr: s.cfg.Listen, Handler: s.mux}
+		servers = append(servers, srv)
+		go func() {
+			errCh <- srv.ListenAndServe()
+		}()
+	}
+
+	if s.cfg.SocketPath != "" {
+		os.Remove(s.cfg.SocketPath)
+		ln, err := net.Listen("unix", s.cfg.SocketPath)
+		if err != nil {
+			return fmt.Errorf("listening on %s: %w", s.cfg.SocketPath, err)
+		}
+		srv := &http.Server{Handler: s.mux, ConnContext: annotateLocalBypass}
+		servers = append(servers, srv)
+		go func() {
+			errCh <- srv.Serve(ln)
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		for _, srv := range servers {
+			srv.Shutdown(shutdownCtx)
+		}
+		return ctx.Err()
+	case err := <-errCh:
+		return err
+	}
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var tokensBucket = []byte("tokens")
+
+// Known scopes. A token's Scopes field is a subset of these; handlers check
+// membership with TokenInfo.HasScope.
+const (
+	ScopeSlingPolecat = "sling:polecat"
+	ScopeSlingDeacon  = "sling:deacon"
+	ScopeReadMail     = "read:mail"
+	ScopeWriteMail    = "write:mail"
+)
+
+// TokenInfo is what's stored (and returned on authentication) for a token.
+type TokenInfo struct {
+	Label     string    `json:"label"`
+	Scopes    []string  `json:"scopes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// HasScope reports whether the token carries scope.
+func (t TokenInfo) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenStore is a BoltDB-backed set of bearer tokens. Only each token's
+// sha256 hash is persisted, so reading the DB file doesn't hand out live
+// credentials.
+type TokenStore struct {
+	db *bolt.DB
+}
+
+// OpenTokenStore opens (creating if necessary) a token store at path.
+func OpenTokenStore(path string) (*TokenStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening token store: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(tokensBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("initializing token store: %w", err)
+	}
+
+	return &TokenStore{db: db}, nil
+}
+
+// Close releases the underlying BoltDB file.
+func (s *TokenStore) Close() error {
+	return s.db.Close()
+}
+
+// hashToken returns the lookup key for a raw bearer token.
+func hashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return []byte(hex.EncodeToString(sum[:]))
+}
+
+// Create mints a new random bearer token with the given label and scopes,
+// returning the raw token (shown to the caller exactly once - only its hash
+// is persisted).
+func (s *TokenStore) Create(label string, scopes []string) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+	token := "gt_" + hex.EncodeToString(raw)
+
+	info := TokenInfo{Label: label, Scopes: scopes, CreatedAt: time.Now()}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return "", fmt.Errorf("encoding token info: %w", err)
+	}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(tokensBucket).Put(hashToken(token), data)
+	})
+	if err != nil {
+		return "", fmt.Errorf("storing token: %w", err)
+	}
+
+	return token, nil
+}
+
+// Revoke deletes a token so it can no longer authenticate.
+func (s *TokenStore) Revoke(token string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(tokensBucket).Delete(hashToken(token))
+	})
+}
+
+// Authenticate looks up token, returning its TokenInfo if valid.
+func (s *TokenStore) Authenticate(token string) (TokenInfo, error) {
+	var info TokenInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(tokensBucket).Get(hashToken(token))
+		if data == nil {
+			return fmt.Errorf("invalid or revoked token")
+		}
+		return json.Unmarshal(data, &info)
+	})
+	return info, err
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/api"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// api command flags
+var (
+	apiListen     string
+	apiSocketPath string
+	apiDBPath     string
+)
+
+var apiCmd = &cobra.Command{
+	Use:   "api",
+	Short: "Run or manage the gastown HTTP API bridge",
+}
+
+var apiServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the HTTP API so other tools can drive gastown programmatically",
+	Long: `Serve a JSON HTTP API exposing the same sling/mail/hook operations 'gt'
+uses directly, so editor plugins, CI bots, and dashboards can drive gastown
+without shelling out to the gt binary.
+
+Bearer tokens (minted with 'gt api token create') are required over TCP.
+Connections over --socket from the local root or owner UID bypass token
+auth entirely, since they could already read every file this API exposes.`,
+	RunE: runAPIServe,
+}
+
+var apiTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage API bearer tokens",
+}
+
+var apiTokenCreateCmd = &cobra.Command{
+	Use:   "create <label> <scope...>",
+	Short: "Mint a new bearer token",
+	Long: `Mint a bearer token with the given scopes and print it once.
+
+Scopes: sling:polecat, sling:deacon, read:mail, write:mail`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runAPITokenCreate,
+}
+
+func init() {
+	apiServeCmd.Flags().StringVar(&apiListen, "listen", "127.0.0.1:7777", "TCP address to serve on (empty to disable)")
+	apiServeCmd.Flags().StringVar(&apiSocketPath, "socket", "", "Unix socket to additionally serve on")
+	apiServeCmd.Flags().StringVar(&apiDBPath, "db", filepath.Join("mayor", "api-tokens.db"), "BoltDB file storing bearer tokens (relative to town root)")
+
+	apiTokenCreateCmd.Flags().StringVar(&apiDBPath, "db", filepath.Join("mayor", "api-tokens.db"), "BoltDB file storing bearer tokens (relative to town root)")
+
+	apiCmd.AddCommand(apiServeCmd)
+	apiTokenCmd.AddCommand(apiTokenCreateCmd)
+	apiCmd.AddCommand(apiTokenCmd)
+	rootCmd.AddCommand(apiCmd)
+}
+
+// resolveAPIDBPath makes apiDBPath absolute against the town root if it
+// isn't already.
+func resolveAPIDBPath(townRoot string) string {
+	if filepath.IsAbs(apiDBPath) {
+		return apiDBPath
+	}
+	return filepath.Join(townRoot, apiDBPath)
+}
+
+func runAPIServe(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	dbPath := resolveAPIDBPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(dbPath), err)
+	}
+
+	tokens, err := api.OpenTokenStore(dbPath)
+	if err != nil {
+		return fmt.Errorf("opening token store: %w", err)
+	}
+	defer tokens.Close()
+
+	cfg := api.Config{
+		Listen:     apiListen,
+		SocketPath: apiSocketPath,
+		TownRoot:   townRoot,
+	}
+
+	server := api.NewServer(cfg, tokens, apiSlingFunc(townRoot), apiHookFunc(townRoot))
+
+	if apiListen != "" {
+		fmt.Printf("%s Listening on %s\n", style.Bold.Render("✓"), apiListen)
+	}
+	if apiSocketPath != "" {
+		fmt.Printf("%s Listening on %s (local root/owner bypass)\n", style.Bold.Render("✓"), apiSocketPath)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	err = server.ListenAndServe(ctx)
+	if err != nil && ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// apiSlingFunc adapts api.SlingRequest onto the same routing functions
+// runSling calls directly, so POST /v1/sling behaves identically to the CLI.
+func apiSlingFunc(townRoot string) api.SlingFunc {
+	return func(ctx context.Context, req api.SlingRequest) (api.SlingResult, error) {
+		target := &SlingTarget{Kind: req.TargetKind, Rig: req.TargetRig, Name: req.TargetName}
+		thing := &SlingThing{Kind: req.ThingKind, ID: req.ThingID, Proto: req.Molecule, IsWisp: req.Wisp}
+		opts := SlingOptions{Force: req.Force, NoStart: req.NoStart, Create: req.Create}
+
+		var outcome SlingOutcome
+		var err error
+		switch target.Kind {
+		case "polecat":
+			err = slingToPolecat(townRoot, target, thing, opts, &outcome)
+		case "deacon":
+			err = slingToDeacon(townRoot, target, thing, opts, &outcome)
+		case "witness":
+			err = slingToWitness(townRoot, target, thing, opts, &outcome)
+		case "refinery":
+			err = slingToRefinery(townRoot, target, thing, opts, &outcome)
+		default:
+			err = fmt.Errorf("unknown target kind: %s", target.Kind)
+		}
+		if err != nil {
+			return api.SlingResult{}, err
+		}
+
+		return api.SlingResult{
+			IssueID:     outcome.IssueID,
+			RootIssueID: outcome.RootIssueID,
+			SessionName: outcome.SessionName,
+		}, nil
+	}
+}
+
+// apiHookFunc adapts GET /v1/hooks/{agent} onto the same handoff-bead
+// lookup checkHookCollision uses.
+func apiHookFunc(townRoot string) api.HookFunc {
+	return func(agent string) (api.HookInfo, error) {
+		target, err := parseSlingTarget(agent, townRoot)
+		if err != nil {
+			return api.HookInfo{}, fmt.Errorf("invalid agent address: %w", err)
+		}
+
+		role := target.Name
+		if role == "" {
+			role = target.Kind
+		}
+
+		rigPath := filepath.Join(townRoot, target.Rig)
+		b := beads.New(rigPath)
+		handoff, err := b.FindHandoffBead(role)
+		if err != nil {
+			return api.HookInfo{}, fmt.Errorf("looking up hook: %w", err)
+		}
+		if handoff == nil {
+			return api.HookInfo{Agent: agent}, nil
+		}
+
+		attachment := beads.ParseAttachmentFields(handoff)
+		info := api.HookInfo{Agent: agent}
+		if attachment != nil {
+			info.AttachedMolecule = attachment.AttachedMolecule
+		}
+		return info, nil
+	}
+}
+
+func runAPITokenCreate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	label := args[0]
+	scopes := args[1:]
+
+	dbPath := resolveAPIDBPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(dbPath), err)
+	}
+
+	tokens, err := api.OpenTokenStore(dbPath)
+	if err != nil {
+		return fmt.Errorf("opening token store: %w", err)
+	}
+	defer tokens.Close()
+
+	token, err := tokens.Create(label, scopes)
+	if err != nil {
+		return fmt.Errorf("creating token: %w", err)
+	}
+
+	fmt.Printf("%s Token created for %s (scopes: %v)\n", style.Bold.Render("✓"), label, scopes)
+	fmt.Println(token)
+	return nil
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/connection"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// connection command flags
+var (
+	connAddIdentityFile string
+	connAddAgentSocket  string
+	connAddHostKey      string
+	connAddFingerprint  string
+	connAddRoots        []string
+)
+
+// systemCmd groups commands that manage the operator's gt installation
+// itself, as opposed to any one town or rig.
+var systemCmd = &cobra.Command{
+	Use:   "system",
+	Short: "Manage gt's own configuration",
+}
+
+var connectionCmd = &cobra.Command{
+	Use:     "connection",
+	Aliases: []string{"connections", "conn"},
+	Short:   "Manage named remote Gas Town endpoints for 'gt sling --connection'",
+	Long: `Manage named remote Gas Town endpoints.
+
+A connection records how to reach a gas town living on another machine -
+an SSH URI, optional identity file or agent socket, the SSH host key it's
+pinned to, and the fingerprint gt expects the remote town to report back.
+The host key is normally left blank and pinned automatically on the first
+successful dial (trust-on-first-use); pass --host-key up front instead if
+you already have it from an out-of-band source. Once registered, 'gt
+sling --connection <name>' (or $GT_CONNECTION) dispatches work there
+instead of requiring the operator to SSH in and run gt locally.`,
+}
+
+var connectionAddCmd = &cobra.Command{
+	Use:   "add <name> <user@host[:port]>",
+	Short: "Register a remote connection",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConnectionAdd,
+}
+
+var connectionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registered connections",
+	Args:  cobra.NoArgs,
+	RunE:  runConnectionList,
+}
+
+var connectionDefaultCmd = &cobra.Command{
+	Use:   "default <name>",
+	Short: "Set the default connection",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConnectionDefault,
+}
+
+var connectionRemoveCmd = &cobra.Command{
+	Use:     "remove <name>",
+	Aliases: []string{"rm"},
+	Short:   "Remove a connection",
+	Args:    cobra.ExactArgs(1),
+	RunE:    runConnectionRemove,
+}
+
+var connectionRenameCmd = &cobra.Command{
+	Use:   "rename <old-name> <new-name>",
+	Short: "Rename a connection",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConnectionRename,
+}
+
+func init() {
+	connectionAddCmd.Flags().StringVar(&connAddIdentityFile, "identity-file", "", "Path to an SSH private key (default: use ssh-agent)")
+	connectionAddCmd.Flags().StringVar(&connAddAgentSocket, "ssh-agent", "", "Override $SSH_AUTH_SOCK for this connection")
+	connectionAddCmd.Flags().StringVar(&connAddHostKey, "host-key", "", "Pin the remote SSH host key up front (authorized_keys format); left blank to pin on first connect")
+	connectionAddCmd.Flags().StringVar(&connAddFingerprint, "fingerprint", "", "Expected remote town fingerprint (see 'gt sling --print-fingerprint')")
+	connectionAddCmd.Flags().StringSliceVar(&connAddRoots, "root", nil, "Candidate town root(s) to search on the remote host")
+
+	connectionCmd.AddCommand(connectionAddCmd)
+	connectionCmd.AddCommand(connectionListCmd)
+	connectionCmd.AddCommand(connectionDefaultCmd)
+	connectionCmd.AddCommand(connectionRemoveCmd)
+	connectionCmd.AddCommand(connectionRenameCmd)
+
+	systemCmd.AddCommand(connectionCmd)
+	rootCmd.AddCommand(systemCmd)
+}
+
+func runConnectionAdd(cmd *cobra.Command, args []string) error {
+	name, uri := args[0], args[1]
+
+	store, err := connection.Load()
+	if err != nil {
+		return fmt.Errorf("loading connections: %w", err)
+	}
+
+	c := connection.Connection{
+		Name:         name,
+		URI:          uri,
+		IdentityFile: connAddIdentityFile,
+		AgentSocket:  connAddAgentSocket,
+		HostKey:      connAddHostKey,
+		Fingerprint:  connAddFingerprint,
+		Roots:        connAddRoots,
+	}
+
+	if err := store.Add(c); err != nil {
+		return fmt.Errorf("adding connection: %w", err)
+	}
+
+	fmt.Printf("%s Added connection %s (%s)\n", style.Bold.Render("✓"), style.Bold.Render(name), uri)
+	if store.Default == name {
+		fmt.Printf("  %s\n", style.Dim.Render("Set as default connection"))
+	}
+	return nil
+}
+
+func runConnectionList(cmd *cobra.Command, args []string) error {
+	store, err := connection.Load()
+	if err != nil {
+		return fmt.Errorf("loading connections: %w", err)
+	}
+
+	if len(store.Connections) == 0 {
+		fmt.Printf("%s\n", style.Dim.Render("(no connections configured)"))
+		return nil
+	}
+
+	fmt.Printf("%s Connections (%d)\n\n", style.Bold.Render("🔌"), len(store.Connections))
+	for name, c := range store.Connections {
+		marker := ""
+		if name == store.Default {
+			marker = " " + style.Dim.Render("(default)")
+		}
+		fmt.Printf("  %s%s\n    %s\n", style.Bold.Render(name), marker, c.URI)
+	}
+	return nil
+}
+
+func runConnectionDefault(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	store, err := connection.Load()
+	if err != nil {
+		return fmt.Errorf("loading connections: %w", err)
+	}
+
+	if err := store.SetDefault(name); err != nil {
+		return fmt.Errorf("setting default connection: %w", err)
+	}
+
+	fmt.Printf("%s %s is now the default connection\n", style.Bold.Render("✓"), style.Bold.Render(name))
+	return nil
+}
+
+func runConnectionRemove(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	store, err := connection.Load()
+	if err != nil {
+		return fmt.Errorf("loading connections: %w", err)
+	}
+
+	if err := store.Remove(name); err != nil {
+		return fmt.Errorf("removing connection: %w", err)
+	}
+
+	fmt.Printf("%s Removed connection %s\n", style.Bold.Render("✓"), style.Bold.Render(name))
+	return nil
+}
+
+func runConnectionRename(cmd *cobra.Command, args []string) error {
+	oldName, newName := args[0], args[1]
+
+	store, err := connection.Load()
+	if err != nil {
+		return fmt.Errorf("loading connections: %w", err)
+	}
+
+	if err := store.Rename(oldName, newName); err != nil {
+		return fmt.Errorf("renaming connection: %w", err)
+	}
+
+	fmt.Printf("%s Renamed connection %s to %s\n", style.Bold.Render("✓"), oldName, style.Bold.Render(newName))
+	return nil
+}
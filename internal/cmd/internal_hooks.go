@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// internal command flags
+var (
+	internalHookRig     string
+	internalHookPolecat string
+	internalHookEvent   string
+)
+
+// internalCmd groups subcommands that `gt` invokes on itself (e.g. from tmux
+// hooks) rather than ones meant for interactive use.
+var internalCmd = &cobra.Command{
+	Use:    "internal",
+	Short:  "Internal plumbing commands",
+	Hidden: true,
+}
+
+var internalPolecatCleanupCmd = &cobra.Command{
+	Use:   "polecat-cleanup",
+	Short: "Clean up a polecat whose tmux session has gone away",
+	Long: `Run the automatic cleanup a polecat's tmux session-closed or
+client-detached hook triggers.
+
+This releases the polecat's name pool slot, clears the beads assignee, and
+removes the worktree if it has no uncommitted changes. It is not meant to be
+run directly - session.Manager.Start registers it as a tmux hook callback.`,
+	RunE: runInternalPolecatCleanup,
+}
+
+func init() {
+	internalPolecatCleanupCmd.Flags().StringVar(&internalHookRig, "rig", "", "Rig the polecat belongs to (required)")
+	internalPolecatCleanupCmd.Flags().StringVar(&internalHookPolecat, "polecat", "", "Polecat name (required)")
+	internalPolecatCleanupCmd.Flags().StringVar(&internalHookEvent, "event", "", "tmux event that triggered cleanup (session-closed, client-detached)")
+	internalPolecatCleanupCmd.MarkFlagRequired("rig")
+	internalPolecatCleanupCmd.MarkFlagRequired("polecat")
+
+	internalCmd.AddCommand(internalPolecatCleanupCmd)
+	rootCmd.AddCommand(internalCmd)
+}
+
+func runInternalPolecatCleanup(cmd *cobra.Command, args []string) error {
+	// client-detached fires even when someone else is still attached or the
+	// session is still running; only session-closed means the polecat is
+	// actually gone.
+	if internalHookEvent == "client-detached" {
+		return nil
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+
+	g := git.NewGit(townRoot)
+	rigMgr := rig.NewManager(townRoot, rigsConfig, g)
+	r, err := rigMgr.GetRig(internalHookRig)
+	if err != nil {
+		return fmt.Errorf("rig '%s' not found", internalHookRig)
+	}
+
+	notifySessionExit(townRoot, r.Name, internalHookPolecat)
+
+	polecatGit := git.NewGit(r.Path)
+	polecatMgr := polecat.NewManager(r, polecatGit)
+
+	sessMgr := session.NewManager(tmux.NewTmux(), r)
+	running, err := sessMgr.IsRunning(internalHookPolecat)
+	if err == nil && running {
+		// A new session was started under the same name before the hook ran.
+		return nil
+	}
+
+	// Clear the beads assignee so the issue becomes available again.
+	_ = polecatMgr.ClearIssue(internalHookPolecat)
+
+	existing, err := polecatMgr.Get(internalHookPolecat)
+	if err != nil {
+		// Already gone - release the pool slot in case it was orphaned.
+		polecatMgr.ReleaseName(internalHookPolecat)
+		return nil
+	}
+
+	pGit := git.NewGit(existing.ClonePath)
+	hasChanges, err := pGit.HasUncommittedChanges()
+	if err != nil || hasChanges {
+		// Leave the worktree for the user to recover, but still release the
+		// pool slot so a fresh polecat can be allocated.
+		polecatMgr.ReleaseName(internalHookPolecat)
+		return nil
+	}
+
+	return polecatMgr.Remove(internalHookPolecat, false)
+}
+
+// notifySessionExit posts a SESSION_EXIT mail to rigName's witness so the
+// death of a session nobody explicitly stopped still gets noticed. This runs
+// from the tmux session-closed hook itself (registered by
+// session.Manager.Start), which is the only reliable signal that a polecat's
+// tmux session actually died - the session's leader process isn't a child of
+// this binary, so there is no exit status for gt to wait on directly.
+func notifySessionExit(townRoot, rigName, polecatName string) {
+	sessionID := session.SessionName(rigName, polecatName)
+	router := mail.NewRouter(townRoot)
+	msg := &mail.Message{
+		To:      fmt.Sprintf("%s/witness", rigName),
+		From:    "session-closed-hook",
+		Subject: fmt.Sprintf("SESSION_EXIT: %s", sessionID),
+		Body:    fmt.Sprintf("Session: %s\nPolecat: %s", sessionID, polecatName),
+	}
+	_ = router.Send(msg) // best-effort - a missed notification isn't fatal
+}
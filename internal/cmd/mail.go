@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// mail command flags
+var (
+	mailExportOut string
+	mailImportIn  string
+)
+
+var mailCmd = &cobra.Command{
+	Use:   "mail",
+	Short: "Inspect and manage agent mailboxes",
+}
+
+var mailExportCmd = &cobra.Command{
+	Use:   "export <address>",
+	Short: "Export a mailbox to an mbox file",
+	Long: `Export an agent's mailbox as an mbox file, for backup or so it can be
+grepped and read with standard mail tooling (e.g. 'mutt -f polecat-alpha.mbox').
+
+<address> uses the same format as 'gt sling's target: polecat/name, deacon/,
+witness/, or rig/polecat/name.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMailExport,
+}
+
+var mailImportCmd = &cobra.Command{
+	Use:   "import <address>",
+	Short: "Import an mbox file into a mailbox",
+	Long: `Restore messages from an mbox file (as written by 'gt mail export', or
+a '.gastown-backups/inbox-*.mbox' snapshot) into an agent's mailbox.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMailImport,
+}
+
+func init() {
+	mailExportCmd.Flags().StringVarP(&mailExportOut, "output", "o", "", "Output mbox file (default: stdout)")
+	mailImportCmd.Flags().StringVarP(&mailImportIn, "input", "i", "", "Input mbox file (required)")
+	mailImportCmd.MarkFlagRequired("input")
+
+	mailCmd.AddCommand(mailExportCmd)
+	mailCmd.AddCommand(mailImportCmd)
+	rootCmd.AddCommand(mailCmd)
+}
+
+// resolveMailAddress parses a sling-style target and returns the rig path
+// mail.Router operates on plus the mailbox address within it.
+func resolveMailAddress(arg string) (rigPath, address string, err error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return "", "", fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	target, err := parseSlingTarget(arg, townRoot)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid address: %w", err)
+	}
+
+	name := target.Name
+	if name == "" {
+		name = target.Kind
+	}
+	return filepath.Join(townRoot, target.Rig), fmt.Sprintf("%s/%s", target.Rig, name), nil
+}
+
+func runMailExport(cmd *cobra.Command, args []string) error {
+	rigPath, address, err := resolveMailAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if mailExportOut != "" {
+		f, err := os.Create(mailExportOut)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", mailExportOut, err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	router := mail.NewRouter(rigPath)
+	if err := router.ExportMbox(address, out); err != nil {
+		return fmt.Errorf("exporting mailbox: %w", err)
+	}
+
+	if mailExportOut != "" {
+		fmt.Fprintf(os.Stderr, "%s Exported %s to %s\n", style.Bold.Render("✓"), address, mailExportOut)
+	}
+	return nil
+}
+
+func runMailImport(cmd *cobra.Command, args []string) error {
+	rigPath, address, err := resolveMailAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	in, err := os.Open(mailImportIn)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", mailImportIn, err)
+	}
+	defer in.Close()
+
+	router := mail.NewRouter(rigPath)
+	if err := router.ImportMbox(address, in); err != nil {
+		return fmt.Errorf("importing mailbox: %w", err)
+	}
+
+	fmt.Printf("%s Imported %s into %s\n", style.Bold.Render("✓"), mailImportIn, address)
+	return nil
+}
@@ -5,8 +5,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
@@ -16,11 +17,13 @@ import (
 
 // Molecule command flags
 var (
-	moleculeJSON          bool
-	moleculeInstParent    string
-	moleculeInstContext   []string
-	moleculeCatalogOnly   bool // List only catalog templates
-	moleculeDBOnly        bool // List only database molecules
+	moleculeJSON         bool
+	moleculeInstParent   string
+	moleculeInstContext  []string
+	moleculeCatalogOnly  bool // List only catalog templates
+	moleculeDBOnly       bool // List only database molecules
+	moleculeParseDryRun  bool
+	moleculeParseContext []string
 )
 
 var moleculeCmd = &cobra.Command{
@@ -82,8 +85,18 @@ var moleculeParseCmd = &cobra.Command{
 	Short: "Validate and show parsed structure",
 	Long: `Parse and validate a molecule definition.
 
-This command parses the molecule's step definitions and reports any errors.
-Useful for debugging molecule definitions before instantiation.`,
+This command parses the molecule's step definitions and reports any errors,
+including a full DAG check over the Needs: graph (missing refs, cycles
+reported with the offending path, and steps unreachable from any root) and
+over each step's With: bindings (a steps.X.outputs.Y expression must name a
+step that declares Y as an output and precedes the referencing step).
+
+Use --dry-run (optionally with --context) to preview the child beads and
+Needs: dependency edges 'gt molecule instantiate' actually creates, plus
+an annotation of each step's With:/When:/OnFailure: directives - these
+are validated here but 'gt molecule instantiate' does not act on them
+yet, so the preview does not reflect real output-binding resolution,
+conditional pending-condition beads, or retry spawning.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMoleculeParse,
 }
@@ -253,6 +266,8 @@ func init() {
 
 	// Parse flags
 	moleculeParseCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
+	moleculeParseCmd.Flags().BoolVar(&moleculeParseDryRun, "dry-run", false, "Show the beads/edges instantiation would create, without creating them")
+	moleculeParseCmd.Flags().StringArrayVar(&moleculeParseContext, "context", nil, "Context variable for --dry-run substitution (key=value)")
 
 	// Instantiate flags
 	moleculeInstantiateCmd.Flags().StringVar(&moleculeInstParent, "parent", "", "Parent issue ID (required)")
@@ -508,22 +523,7 @@ func runMoleculeShow(cmd *cobra.Command, args []string) error {
 	if len(steps) == 0 {
 		fmt.Printf("  %s\n", style.Dim.Render("(no steps defined)"))
 	} else {
-		// Find which steps are ready (no dependencies)
-		for _, step := range steps {
-			needsStr := ""
-			if len(step.Needs) == 0 {
-				needsStr = style.Dim.Render("(ready first)")
-			} else {
-				needsStr = fmt.Sprintf("Needs: %s", strings.Join(step.Needs, ", "))
-			}
-
-			tierStr := ""
-			if step.Tier != "" {
-				tierStr = fmt.Sprintf(" [%s]", step.Tier)
-			}
-
-			fmt.Printf("  %-12s â†’ %s%s\n", step.Ref, needsStr, tierStr)
-		}
+		renderMoleculeSteps(steps, workDir, "  ", 0)
 	}
 
 	// Count instances (need beads client for this)
@@ -554,16 +554,34 @@ func runMoleculeParse(cmd *cobra.Command, args []string) error {
 	// Parse steps regardless of validation
 	steps, parseErr := beads.ParseMoleculeSteps(mol.Description)
 
+	// Full DAG check over the parsed steps: cycles, missing refs, orphans.
+	// This catches structural problems ValidateMolecule's per-step checks
+	// can't, since they only show up once every step is known.
+	dag := validateMoleculeDAG(steps)
+
+	var plan *dryRunPlan
+	if moleculeParseDryRun {
+		ctx, err := parseContextFlags(moleculeParseContext)
+		if err != nil {
+			return err
+		}
+		plan = planDryRunInstantiate(steps, ctx)
+	}
+
 	if moleculeJSON {
 		type parseOutput struct {
 			Valid           bool                 `json:"valid"`
 			ValidationError string               `json:"validation_error,omitempty"`
 			ParseError      string               `json:"parse_error,omitempty"`
 			Steps           []beads.MoleculeStep `json:"steps"`
+			DAG             *DAGValidation       `json:"dag,omitempty"`
+			DryRun          *dryRunPlan          `json:"dry_run,omitempty"`
 		}
 		out := parseOutput{
-			Valid: validationErr == nil,
-			Steps: steps,
+			Valid:  validationErr == nil && dag.Valid(),
+			Steps:  steps,
+			DAG:    dag,
+			DryRun: plan,
 		}
 		if validationErr != nil {
 			out.ValidationError = validationErr.Error()
@@ -581,15 +599,22 @@ func runMoleculeParse(cmd *cobra.Command, args []string) error {
 
 	if validationErr != nil {
 		fmt.Printf("%s Validation failed: %s\n\n", style.Bold.Render("âœ—"), validationErr)
+	} else if !dag.Valid() {
+		fmt.Printf("%s DAG validation failed\n\n", style.Bold.Render("âœ—"))
 	} else {
 		fmt.Printf("%s Valid molecule\n\n", style.Bold.Render("âœ“"))
 	}
+	printDAGValidation(dag)
 
 	if parseErr != nil {
 		fmt.Printf("Parse error: %s\n\n", parseErr)
 	}
 
-	fmt.Printf("Parsed Steps (%d):\n", len(steps))
+	if plan != nil {
+		printDryRunPlan(plan)
+	}
+
+	fmt.Printf("\nParsed Steps (%d):\n", len(steps))
 	for i, step := range steps {
 		fmt.Printf("\n  [%d] %s\n", i+1, style.Bold.Render(step.Ref))
 		if step.Title != step.Ref {
@@ -651,6 +676,17 @@ func runMoleculeInstantiate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid molecule: %w", err)
 	}
 
+	// Gate on a full DAG check - a molecule with cycles, dangling Needs:,
+	// or orphaned steps must never reach child-bead creation.
+	steps, parseErr := beads.ParseMoleculeSteps(mol.Description)
+	if parseErr != nil {
+		return fmt.Errorf("parsing steps: %w", parseErr)
+	}
+	if dag := validateMoleculeDAG(steps); !dag.Valid() {
+		printDAGValidation(dag)
+		return fmt.Errorf("molecule %s fails DAG validation (see above)", molID)
+	}
+
 	// Get the parent issue
 	parent, err := b.Show(moleculeInstParent)
 	if err != nil {
@@ -658,13 +694,9 @@ func runMoleculeInstantiate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Parse context variables
-	ctx := make(map[string]string)
-	for _, kv := range moleculeInstContext {
-		parts := strings.SplitN(kv, "=", 2)
-		if len(parts) != 2 {
-			return fmt.Errorf("invalid context format %q (expected key=value)", kv)
-		}
-		ctx[parts[0]] = parts[1]
+	ctx, err := parseContextFlags(moleculeInstContext)
+	if err != nil {
+		return err
 	}
 
 	// Instantiate the molecule
@@ -815,6 +847,35 @@ type MoleculeProgressInfo struct {
 	BlockedSteps []string `json:"blocked_steps"`
 	Percent      int      `json:"percent_complete"`
 	Complete     bool     `json:"complete"`
+
+	// Skipped counts steps outside the transitive closure of the
+	// molecule's target: list (see moleculeTargetClosure) - not counted
+	// in TotalSteps, since a target-gated molecule is "done" once its
+	// targets close regardless of what else the template defines.
+	Skipped int `json:"skipped_steps,omitempty"`
+
+	// NextWave is every ready step's ID - every step whose dependencies
+	// are all satisfied right now - so callers can suggest dispatching
+	// all of them in parallel rather than just ReadySteps[0].
+	NextWave []string `json:"next_wave,omitempty"`
+
+	// CriticalPath is the sequence of step IDs forming the longest
+	// remaining (weighted by each step's estimate, default 1, zero once
+	// closed) root-to-leaf chain in the instance's DependsOn graph - the
+	// chain that determines how much longer the molecule can possibly take.
+	CriticalPath []string `json:"critical_path,omitempty"`
+
+	// BlockingRoot maps a blocked step's ID to the earliest not-yet-closed
+	// predecessor(s) it's actually waiting on - the steps that are
+	// currently workable (or, if none are, the next ones back) and that
+	// everything downstream of them is stalled behind.
+	BlockingRoot map[string][]string `json:"blocking_root,omitempty"`
+
+	// CurrentWave/TotalWaves describe progress through the molecule's
+	// planned execution waves (see planMoleculeExecution); both are 0 if
+	// the template's steps couldn't be matched against the instance.
+	CurrentWave int `json:"current_wave,omitempty"`
+	TotalWaves  int `json:"total_waves,omitempty"`
 }
 
 func runMoleculeProgress(cmd *cobra.Command, args []string) error {
@@ -827,80 +888,21 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 
 	b := beads.New(workDir)
 
-	// Get the root issue
-	root, err := b.Show(rootID)
-	if err != nil {
+	// Get the root issue, just to fail fast with a clear error if it
+	// doesn't exist - getMoleculeProgressInfo re-fetches it too, but a
+	// missing root and a root with no steps need distinct error messages.
+	if _, err := b.Show(rootID); err != nil {
 		return fmt.Errorf("getting root issue: %w", err)
 	}
 
-	// Find all children of the root issue
-	children, err := b.List(beads.ListOptions{
-		Parent:   rootID,
-		Status:   "all",
-		Priority: -1,
-	})
+	progressPtr, err := getMoleculeProgressInfo(b, workDir, rootID)
 	if err != nil {
-		return fmt.Errorf("listing children: %w", err)
+		return err
 	}
-
-	if len(children) == 0 {
+	if progressPtr == nil {
 		return fmt.Errorf("no steps found for %s (not a molecule root?)", rootID)
 	}
-
-	// Build progress info
-	progress := MoleculeProgressInfo{
-		RootID:    rootID,
-		RootTitle: root.Title,
-	}
-
-	// Try to find molecule ID from first child's description
-	for _, child := range children {
-		if molID := extractMoleculeID(child.Description); molID != "" {
-			progress.MoleculeID = molID
-			break
-		}
-	}
-
-	// Build set of closed issue IDs for dependency checking
-	closedIDs := make(map[string]bool)
-	for _, child := range children {
-		if child.Status == "closed" {
-			closedIDs[child.ID] = true
-		}
-	}
-
-	// Categorize steps
-	for _, child := range children {
-		progress.TotalSteps++
-
-		switch child.Status {
-		case "closed":
-			progress.DoneSteps++
-		case "in_progress":
-			progress.InProgress++
-		case "open":
-			// Check if all dependencies are closed
-			allDepsClosed := true
-			for _, depID := range child.DependsOn {
-				if !closedIDs[depID] {
-					allDepsClosed = false
-					break
-				}
-			}
-
-			if len(child.DependsOn) == 0 || allDepsClosed {
-				progress.ReadySteps = append(progress.ReadySteps, child.ID)
-			} else {
-				progress.BlockedSteps = append(progress.BlockedSteps, child.ID)
-			}
-		}
-	}
-
-	// Calculate completion percentage
-	if progress.TotalSteps > 0 {
-		progress.Percent = (progress.DoneSteps * 100) / progress.TotalSteps
-	}
-	progress.Complete = progress.DoneSteps == progress.TotalSteps
+	progress := *progressPtr
 
 	// JSON output
 	if moleculeJSON {
@@ -910,11 +912,14 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 	}
 
 	// Human-readable output
-	fmt.Printf("\n%s %s\n\n", style.Bold.Render("ðŸ§¬ Molecule Progress:"), root.Title)
+	fmt.Printf("\n%s %s\n\n", style.Bold.Render("ðŸ§¬ Molecule Progress:"), progress.RootTitle)
 	fmt.Printf("  Root: %s\n", rootID)
 	if progress.MoleculeID != "" {
 		fmt.Printf("  Molecule: %s\n", progress.MoleculeID)
 	}
+	if progress.TotalWaves > 0 {
+		fmt.Printf("  Wave: %d of %d\n", progress.CurrentWave, progress.TotalWaves)
+	}
 	fmt.Println()
 
 	// Progress bar
@@ -932,6 +937,14 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Println()
 	fmt.Printf("  Blocked:     %d\n", len(progress.BlockedSteps))
+	if progress.Skipped > 0 {
+		fmt.Printf("  Skipped:     %d %s\n", progress.Skipped, style.Dim.Render("(outside target: closure)"))
+	}
+
+	if len(progress.CriticalPath) > 1 {
+		fmt.Printf("\n  Critical path: %s\n", strings.Join(progress.CriticalPath, " -> "))
+	}
+	printBlockingRoots(progress.BlockingRoot)
 
 	if progress.Complete {
 		fmt.Printf("\n  %s\n", style.Bold.Render("âœ“ Molecule complete!"))
@@ -952,6 +965,33 @@ func extractMoleculeID(description string) string {
 	return ""
 }
 
+// extractMoleculeTargets scrapes a molecule root's optional "target:" line -
+// a comma-separated list of leaf step refs that must complete for the
+// molecule to be considered done, Argo Workflows-style. Steps outside the
+// transitive closure of every target are skipped rather than instantiated
+// (see moleculeTargetClosure). Returns nil if the molecule has no target:
+// line, meaning every step is required, same as today.
+func extractMoleculeTargets(description string) []string {
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "target:") {
+			continue
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(line, "target:"))
+		if rest == "" {
+			return nil
+		}
+		var targets []string
+		for _, t := range strings.Split(rest, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				targets = append(targets, t)
+			}
+		}
+		return targets
+	}
+	return nil
+}
+
 func runMoleculeAttach(cmd *cobra.Command, args []string) error {
 	pinnedBeadID := args[0]
 	moleculeID := args[1]
@@ -963,6 +1003,32 @@ func runMoleculeAttach(cmd *cobra.Command, args []string) error {
 
 	b := beads.New(workDir)
 
+	// If the proto came from a locked catalog source, verify its fetched
+	// description still matches what sync recorded before creating any
+	// child beads from it - a proto whose content drifted underneath a
+	// pinned source must not execute silently differently across agents.
+	// Resolution mirrors runMoleculeInstantiate: catalog first, DB fallback.
+	var protoDescription string
+	if catalog, err := loadMoleculeCatalog(workDir); err == nil {
+		if catalogMol := catalog.Get(moleculeID); catalogMol != nil {
+			protoDescription = catalogMol.Description
+		}
+	}
+	if protoDescription == "" {
+		if proto, err := b.Show(moleculeID); err == nil {
+			protoDescription = proto.Description
+		}
+	}
+	if protoDescription != "" {
+		if lock, lockErr := loadCatalogLockfile(filepath.Join(workDir, ".beads", "molecules.lock")); lockErr == nil {
+			if locked := lock.ProtoDigest(moleculeID); locked != "" {
+				if current := contentHash(protoDescription); current != locked {
+					return fmt.Errorf("molecule %s has drifted from its locked digest (%s != %s) - rerun 'gt mol catalog sync --update' if this is expected", moleculeID, current, locked)
+				}
+			}
+		}
+	}
+
 	// Attach the molecule
 	issue, err := b.AttachMolecule(pinnedBeadID, moleculeID)
 	if err != nil {
@@ -1083,18 +1149,38 @@ type MoleculeStatusInfo struct {
 }
 
 func runMoleculeStatus(cmd *cobra.Command, args []string) error {
+	status, err := gatherMoleculeStatus(args)
+	if err != nil {
+		return err
+	}
+
+	// JSON output
+	if moleculeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(status)
+	}
+
+	// Human-readable output
+	return outputMoleculeStatus(status)
+}
+
+// gatherMoleculeStatus builds a MoleculeStatusInfo for target (or, if args is
+// empty, the current agent), the same work runMoleculeStatus did inline
+// before 'gt mol watch' needed to call it once per tick too.
+func gatherMoleculeStatus(args []string) (MoleculeStatusInfo, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
-		return fmt.Errorf("getting current directory: %w", err)
+		return MoleculeStatusInfo{}, fmt.Errorf("getting current directory: %w", err)
 	}
 
 	// Find town root
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
-		return fmt.Errorf("finding workspace: %w", err)
+		return MoleculeStatusInfo{}, fmt.Errorf("finding workspace: %w", err)
 	}
 	if townRoot == "" {
-		return fmt.Errorf("not in a Gas Town workspace")
+		return MoleculeStatusInfo{}, fmt.Errorf("not in a Gas Town workspace")
 	}
 
 	// Determine target agent
@@ -1109,14 +1195,14 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 		roleCtx = detectRole(cwd, townRoot)
 		target = buildAgentIdentity(roleCtx)
 		if target == "" {
-			return fmt.Errorf("cannot determine agent identity from current directory (role: %s)", roleCtx.Role)
+			return MoleculeStatusInfo{}, fmt.Errorf("cannot determine agent identity from current directory (role: %s)", roleCtx.Role)
 		}
 	}
 
 	// Find beads directory
 	workDir, err := findLocalBeadsDir()
 	if err != nil {
-		return fmt.Errorf("not in a beads workspace: %w", err)
+		return MoleculeStatusInfo{}, fmt.Errorf("not in a beads workspace: %w", err)
 	}
 
 	b := beads.New(workDir)
@@ -1128,7 +1214,7 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 		Priority: -1,
 	})
 	if err != nil {
-		return fmt.Errorf("listing pinned beads: %w", err)
+		return MoleculeStatusInfo{}, fmt.Errorf("listing pinned beads: %w", err)
 	}
 
 	// Build status info
@@ -1154,7 +1240,7 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 
 			// Get progress if there's an attached molecule
 			if attachment.AttachedMolecule != "" {
-				progress, _ := getMoleculeProgressInfo(b, attachment.AttachedMolecule)
+				progress, _ := getMoleculeProgressInfo(b, workDir, attachment.AttachedMolecule)
 				status.Progress = progress
 
 				// Determine next action
@@ -1170,15 +1256,7 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 		status.NextAction = "Attach a molecule to start work: gt mol attach <bead-id> <molecule-id>"
 	}
 
-	// JSON output
-	if moleculeJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(status)
-	}
-
-	// Human-readable output
-	return outputMoleculeStatus(status)
+	return status, nil
 }
 
 // buildAgentIdentity constructs the agent identity string from role context.
@@ -1202,7 +1280,7 @@ func buildAgentIdentity(ctx RoleContext) string {
 }
 
 // getMoleculeProgressInfo gets progress info for a molecule instance.
-func getMoleculeProgressInfo(b *beads.Beads, moleculeRootID string) (*MoleculeProgressInfo, error) {
+func getMoleculeProgressInfo(b *beads.Beads, workDir, moleculeRootID string) (*MoleculeProgressInfo, error) {
 	// Get the molecule root issue
 	root, err := b.Show(moleculeRootID)
 	if err != nil {
@@ -1238,6 +1316,16 @@ func getMoleculeProgressInfo(b *beads.Beads, moleculeRootID string) (*MoleculePr
 		}
 	}
 
+	// If the template declares a target: list, compute which step refs are
+	// actually required to produce it, so a child outside that closure can
+	// be categorized as skipped instead of ready/blocked/done.
+	var requiredRefs map[string]bool
+	if progress.MoleculeID != "" {
+		if templateSteps, err := loadNestedMolecule(workDir, progress.MoleculeID); err == nil {
+			requiredRefs = moleculeTargetClosure(templateSteps, extractMoleculeTargets(root.Description))
+		}
+	}
+
 	// Build set of closed issue IDs for dependency checking
 	closedIDs := make(map[string]bool)
 	for _, child := range children {
@@ -1248,6 +1336,24 @@ func getMoleculeProgressInfo(b *beads.Beads, moleculeRootID string) (*MoleculePr
 
 	// Categorize steps
 	for _, child := range children {
+		if sub, ok := nestedSubProgress(b, workDir, child); ok {
+			// child is itself a nested-molecule container: fold its sub-DAG's
+			// totals in directly rather than counting the container as one step.
+			progress.TotalSteps += sub.TotalSteps
+			progress.DoneSteps += sub.DoneSteps
+			progress.InProgress += sub.InProgress
+			progress.ReadySteps = append(progress.ReadySteps, sub.ReadySteps...)
+			progress.BlockedSteps = append(progress.BlockedSteps, sub.BlockedSteps...)
+			continue
+		}
+
+		if requiredRefs != nil {
+			if ref := extractStepRef(child.Description); ref != "" && !requiredRefs[ref] {
+				progress.Skipped++
+				continue
+			}
+		}
+
 		progress.TotalSteps++
 
 		switch child.Status {
@@ -1278,10 +1384,223 @@ func getMoleculeProgressInfo(b *beads.Beads, moleculeRootID string) (*MoleculePr
 		progress.Percent = (progress.DoneSteps * 100) / progress.TotalSteps
 	}
 	progress.Complete = progress.DoneSteps == progress.TotalSteps
+	progress.NextWave = progress.ReadySteps
+
+	annotateWaveProgress(progress, workDir, children)
+	annotateCriticalPath(progress, children, closedIDs)
 
 	return progress, nil
 }
 
+// annotateWaveProgress fills in progress.CurrentWave/TotalWaves by planning
+// the molecule template's execution and matching each wave's step refs
+// against the instantiated children's "step_ref:" description field (the
+// convention InstantiateMolecule is expected to stamp on a child bead,
+// mirroring how extractMoleculeID scrapes "instantiated_from:"). Left unset
+// if the template can't be loaded or no child matches any ref, rather than
+// reporting a wave number derived from an empty mapping.
+func annotateWaveProgress(progress *MoleculeProgressInfo, workDir string, children []*beads.Issue) {
+	if progress.MoleculeID == "" {
+		return
+	}
+
+	steps, err := loadNestedMolecule(workDir, progress.MoleculeID)
+	if err != nil {
+		return
+	}
+
+	costs, err := loadTierCosts()
+	if err != nil {
+		costs = nil // fall back to the uniform default cost rather than losing wave info
+	}
+
+	waves, totalDepth, err := planMoleculeExecution(steps, 0, costs)
+	if err != nil {
+		return
+	}
+
+	refStatus := make(map[string]string, len(children))
+	for _, c := range children {
+		if ref := extractStepRef(c.Description); ref != "" {
+			refStatus[ref] = c.Status
+		}
+	}
+	if len(refStatus) == 0 {
+		return
+	}
+
+	progress.TotalWaves = totalDepth
+	progress.CurrentWave = totalDepth
+	for i, w := range waves {
+		allDone := true
+		for _, ref := range w.Refs {
+			if refStatus[ref] != "closed" {
+				allDone = false
+				break
+			}
+		}
+		if !allDone {
+			progress.CurrentWave = i + 1
+			break
+		}
+	}
+}
+
+// annotateCriticalPath fills in progress.CriticalPath and progress.BlockingRoot
+// from the instance's own DependsOn graph (not the template - the template's
+// critical path is planMoleculeExecution/criticalPath's job; this one reflects
+// actual status, so a closed step contributes zero remaining weight).
+func annotateCriticalPath(progress *MoleculeProgressInfo, children []*beads.Issue, closedIDs map[string]bool) {
+	byID := make(map[string]*beads.Issue, len(children))
+	dependents := make(map[string][]string, len(children)) // depID -> steps that depend on it
+	for _, c := range children {
+		byID[c.ID] = c
+		for _, depID := range c.DependsOn {
+			dependents[depID] = append(dependents[depID], c.ID)
+		}
+	}
+
+	dist := make(map[string]int, len(children))
+	next := make(map[string]string, len(children))
+	var longest func(id string) int
+	longest = func(id string) int {
+		if d, ok := dist[id]; ok {
+			return d
+		}
+		dist[id] = 0 // pre-seed so a cycle can't recurse forever
+		weight := 0
+		if byID[id].Status != "closed" {
+			weight = extractStepEstimate(byID[id].Description)
+		}
+
+		best, bestDep := 0, ""
+		for _, dep := range dependents[id] {
+			if d := longest(dep); d > best {
+				best, bestDep = d, dep
+			}
+		}
+
+		dist[id] = weight + best
+		next[id] = bestDep
+		return dist[id]
+	}
+
+	start, bestDist := "", -1
+	for _, c := range children {
+		if len(c.DependsOn) > 0 {
+			continue // only a root (nothing it needs) can start the chain
+		}
+		if d := longest(c.ID); d > bestDist {
+			start, bestDist = c.ID, d
+		}
+	}
+	for id := start; id != ""; id = next[id] {
+		progress.CriticalPath = append(progress.CriticalPath, id)
+	}
+
+	progress.BlockingRoot = make(map[string][]string)
+	rootsMemo := make(map[string][]string)
+	for _, id := range progress.BlockedSteps {
+		roots := blockingRoots(id, byID, closedIDs, rootsMemo)
+		sort.Strings(roots)
+		progress.BlockingRoot[id] = roots
+	}
+}
+
+// blockingRoots finds the earliest not-yet-closed predecessors of id: an
+// unfinished dependency that itself has no unfinished dependency of its own
+// (i.e. it's currently workable, so everything behind it is waiting on it
+// specifically) - recursing past any dependency that's still blocked on
+// something earlier.
+func blockingRoots(id string, byID map[string]*beads.Issue, closedIDs map[string]bool, memo map[string][]string) []string {
+	if roots, ok := memo[id]; ok {
+		return roots
+	}
+	memo[id] = nil // pre-seed so a cycle can't recurse forever
+
+	seen := make(map[string]bool)
+	var roots []string
+	for _, depID := range byID[id].DependsOn {
+		if closedIDs[depID] {
+			continue
+		}
+
+		depHasUnfinishedDep := false
+		for _, d2 := range byID[depID].DependsOn {
+			if !closedIDs[d2] {
+				depHasUnfinishedDep = true
+				break
+			}
+		}
+
+		if !depHasUnfinishedDep {
+			if !seen[depID] {
+				seen[depID] = true
+				roots = append(roots, depID)
+			}
+			continue
+		}
+
+		for _, r := range blockingRoots(depID, byID, closedIDs, memo) {
+			if !seen[r] {
+				seen[r] = true
+				roots = append(roots, r)
+			}
+		}
+	}
+
+	memo[id] = roots
+	return roots
+}
+
+// extractStepEstimate scrapes a child bead's optional "estimate: N" line -
+// its relative cost for critical-path weighting - defaulting to 1 if absent
+// or unparseable, the same default planMoleculeExecution/tierCost use for
+// an unconfigured tier.
+func extractStepEstimate(description string) int {
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "estimate:") {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "estimate:"))); err == nil {
+			return n
+		}
+	}
+	return 1
+}
+
+// printBlockingRoots groups blocked steps by the earliest predecessor(s)
+// they share (progress.BlockingRoot) so an agent sees "waiting on bd-42 ->
+// 3 downstream steps" instead of a flat list with no sense of what to fix
+// first.
+func printBlockingRoots(blockingRoot map[string][]string) {
+	if len(blockingRoot) == 0 {
+		return
+	}
+
+	byRoot := make(map[string][]string)
+	for step, roots := range blockingRoot {
+		key := strings.Join(roots, ", ")
+		if key == "" {
+			key = "(unknown)"
+		}
+		byRoot[key] = append(byRoot[key], step)
+	}
+
+	var keys []string
+	for k := range byRoot {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		steps := byRoot[k]
+		sort.Strings(steps)
+		fmt.Printf("    waiting on %s -> %d downstream step(s): %s\n", k, len(steps), strings.Join(steps, ", "))
+	}
+}
+
 // determineNextAction suggests the next action based on status.
 func determineNextAction(status MoleculeStatusInfo) string {
 	if status.Progress == nil {
@@ -1296,8 +1615,12 @@ func determineNextAction(status MoleculeStatusInfo) string {
 		return "Continue working on in-progress steps"
 	}
 
-	if len(status.Progress.ReadySteps) > 0 {
-		return fmt.Sprintf("Start next ready step: bd update %s --status=in_progress", status.Progress.ReadySteps[0])
+	if len(status.Progress.NextWave) == 1 {
+		return fmt.Sprintf("Start next ready step: bd update %s --status=in_progress", status.Progress.NextWave[0])
+	}
+	if len(status.Progress.NextWave) > 1 {
+		return fmt.Sprintf("Start %d ready steps in parallel: bd update {%s} --status=in_progress",
+			len(status.Progress.NextWave), strings.Join(status.Progress.NextWave, ","))
 	}
 
 	if len(status.Progress.BlockedSteps) > 0 {
@@ -1359,6 +1682,14 @@ func outputMoleculeStatus(status MoleculeStatusInfo) error {
 		}
 		fmt.Println()
 		fmt.Printf("  Blocked:     %d\n", len(status.Progress.BlockedSteps))
+		if status.Progress.Skipped > 0 {
+			fmt.Printf("  Skipped:     %d\n", status.Progress.Skipped)
+		}
+
+		if len(status.Progress.CriticalPath) > 1 {
+			fmt.Printf("\nCritical path: %s\n", strings.Join(status.Progress.CriticalPath, " -> "))
+		}
+		printBlockingRoots(status.Progress.BlockingRoot)
 
 		if status.Progress.Complete {
 			fmt.Printf("\n%s\n", style.Bold.Render("âœ“ Molecule complete!"))
@@ -1380,31 +1711,38 @@ func runMoleculeCatalog(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a beads workspace: %w", err)
 	}
 
-	// Load catalog
-	catalog, err := loadMoleculeCatalog(workDir)
+	// Load catalog, merging in any protos cached from the last
+	// 'gt mol catalog sync' run (git/http/oci sources) alongside the
+	// built-in/town/rig/project hierarchy.
+	molecules, lock, err := mergedCatalogProtos(workDir)
 	if err != nil {
 		return fmt.Errorf("loading catalog: %w", err)
 	}
 
-	molecules := catalog.List()
-
 	if moleculeJSON {
 		type catalogEntry struct {
 			ID        string `json:"id"`
 			Title     string `json:"title"`
 			Source    string `json:"source"`
 			StepCount int    `json:"step_count"`
+			SourceRef string `json:"source_ref,omitempty"`
+			Digest    string `json:"digest,omitempty"`
 		}
 
 		var entries []catalogEntry
 		for _, mol := range molecules {
 			steps, _ := beads.ParseMoleculeSteps(mol.Description)
-			entries = append(entries, catalogEntry{
+			entry := catalogEntry{
 				ID:        mol.ID,
 				Title:     mol.Title,
 				Source:    mol.Source,
 				StepCount: len(steps),
-			})
+			}
+			if src := lockedProtoSource(lock, mol.ID); src != "" {
+				entry.SourceRef = src
+				entry.Digest = lock.ProtoDigest(mol.ID)
+			}
+			entries = append(entries, entry)
 		}
 
 		enc := json.NewEncoder(os.Stdout)
@@ -1573,30 +1911,15 @@ func runMoleculeSquash(cmd *cobra.Command, args []string) error {
 
 	moleculeID := attachment.AttachedMolecule
 
-	// Get progress info for the digest
-	progress, _ := getMoleculeProgressInfo(b, moleculeID)
+	// Build the SBOM-style digest manifest from the instance's children
+	manifest, err := buildDigestManifest(b, moleculeID, target)
+	if err != nil {
+		return fmt.Errorf("building digest manifest: %w", err)
+	}
 
 	// Create a digest issue
 	digestTitle := fmt.Sprintf("Digest: %s", moleculeID)
-	digestDesc := fmt.Sprintf(`Squashed molecule execution.
-
-molecule: %s
-agent: %s
-squashed_at: %s
-`, moleculeID, target, time.Now().UTC().Format(time.RFC3339))
-
-	if progress != nil {
-		digestDesc += fmt.Sprintf(`
-## Execution Summary
-- Steps: %d/%d completed
-- Status: %s
-`, progress.DoneSteps, progress.TotalSteps, func() string {
-			if progress.Complete {
-				return "complete"
-			}
-			return "partial"
-		}())
-	}
+	digestDesc := renderDigestManifest(manifest)
 
 	// Create the digest bead
 	digestIssue, err := b.Create(beads.CreateOptions{
@@ -1631,12 +1954,11 @@ squashed_at: %s
 	}
 
 	if moleculeJSON {
-		result := map[string]interface{}{
-			"squashed":   moleculeID,
-			"digest_id":  digestIssue.ID,
-			"from":       target,
-			"handoff_id": handoff.ID,
-		}
+		result := struct {
+			*DigestManifest
+			DigestID  string `json:"digest_id"`
+			HandoffID string `json:"handoff_id"`
+		}{DigestManifest: manifest, DigestID: digestIssue.ID, HandoffID: handoff.ID}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(result)
@@ -0,0 +1,780 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Molecule catalog sync command flags.
+var moleculeCatalogSyncUpdate bool
+
+var moleculeCatalogSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Resolve configured catalog sources and refresh the lockfile",
+	Long: `Resolve every source listed in .beads/molecules.yaml (file, git, http,
+or oci) and record the resolved ref and content digest for each proto into
+.beads/molecules.lock.
+
+Without --update, sync only verifies: if a source's current fetch doesn't
+match what's already locked, it fails rather than silently drifting -
+protos are meant to execute identically regardless of which agent's
+machine fetched them. Pass --update to accept the new resolution and
+rewrite the lockfile.`,
+	RunE: runMoleculeCatalogSync,
+}
+
+func init() {
+	moleculeCatalogSyncCmd.Flags().BoolVar(&moleculeCatalogSyncUpdate, "update", false, "Accept newly-resolved sources and rewrite the lockfile")
+	moleculeCatalogSyncCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output the resolved lockfile as JSON")
+	moleculeCatalogCmd.AddCommand(moleculeCatalogSyncCmd)
+}
+
+// CatalogSourceConfig is one entry in .beads/molecules.yaml: a named,
+// prioritized place to fetch molecule protos from.
+type CatalogSourceConfig struct {
+	Name     string
+	Type     string // file, git, http, oci - inferred from URI if empty
+	URI      string
+	Priority int
+}
+
+// CatalogSource fetches molecule protos from one configured location and
+// reports what it actually fetched, so sync can lock it and attach can
+// later verify against that lock.
+type CatalogSource interface {
+	// Fetch retrieves the protos currently available at this source.
+	Fetch(ctx context.Context) ([]beads.MoleculeProto, error)
+	// Ref returns the resolved version of the last Fetch: a git commit SHA,
+	// an HTTP ETag/content hash, or an OCI manifest digest. Empty until
+	// Fetch has run once.
+	Ref() string
+}
+
+// newCatalogSource builds the CatalogSource implementation matching cfg's
+// type (inferring it from the URI scheme when cfg.Type is unset).
+func newCatalogSource(cfg CatalogSourceConfig) (CatalogSource, error) {
+	kind := cfg.Type
+	if kind == "" {
+		switch {
+		case strings.HasPrefix(cfg.URI, "file://"):
+			kind = "file"
+		case strings.HasPrefix(cfg.URI, "git+"):
+			kind = "git"
+		case strings.HasPrefix(cfg.URI, "oci://"):
+			kind = "oci"
+		case strings.HasPrefix(cfg.URI, "http://"), strings.HasPrefix(cfg.URI, "https://"):
+			kind = "http"
+		default:
+			return nil, fmt.Errorf("source %q: cannot infer type from URI %q, set type: explicitly", cfg.Name, cfg.URI)
+		}
+	}
+
+	switch kind {
+	case "file":
+		return &fileCatalogSource{path: strings.TrimPrefix(cfg.URI, "file://")}, nil
+	case "git":
+		return &gitCatalogSource{uri: strings.TrimPrefix(cfg.URI, "git+")}, nil
+	case "http":
+		return &httpCatalogSource{uri: cfg.URI}, nil
+	case "oci":
+		return &ociCatalogSource{uri: strings.TrimPrefix(cfg.URI, "oci://")}, nil
+	default:
+		return nil, fmt.Errorf("source %q: unknown type %q", cfg.Name, kind)
+	}
+}
+
+// parseMoleculeProtosJSONL decodes one beads.MoleculeProto per non-blank
+// line, the same molecules.jsonl shape loadMoleculeCatalog reads locally.
+func parseMoleculeProtosJSONL(data []byte, source string) ([]beads.MoleculeProto, error) {
+	var protos []beads.MoleculeProto
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var p beads.MoleculeProto
+		if err := json.Unmarshal([]byte(line), &p); err != nil {
+			return nil, fmt.Errorf("parsing proto line: %w", err)
+		}
+		p.Source = source
+		protos = append(protos, p)
+	}
+	return protos, nil
+}
+
+// fileCatalogSource reads *.jsonl proto files from a local directory (or a
+// single file), for sources that are just a checked-out or synced path.
+type fileCatalogSource struct {
+	path string
+}
+
+func (s *fileCatalogSource) Ref() string { return "file://" + s.path }
+
+func (s *fileCatalogSource) Fetch(ctx context.Context) ([]beads.MoleculeProto, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("stat %s: %w", s.path, err)
+	}
+
+	var files []string
+	if info.IsDir() {
+		matches, err := filepath.Glob(filepath.Join(s.path, "*.jsonl"))
+		if err != nil {
+			return nil, err
+		}
+		files = matches
+	} else {
+		files = []string{s.path}
+	}
+
+	var all []beads.MoleculeProto
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", f, err)
+		}
+		protos, err := parseMoleculeProtosJSONL(data, "file:"+s.path)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, protos...)
+	}
+	return all, nil
+}
+
+// gitCatalogSource clones (shallow) a git repo and reads its *.jsonl proto
+// files, recording the resolved HEAD commit as Ref() - so a lockfile entry
+// pins an exact commit, not a moving branch name.
+type gitCatalogSource struct {
+	uri      string // repo URL, optionally "<url>@<ref>"
+	resolved string
+}
+
+func (s *gitCatalogSource) Ref() string { return s.resolved }
+
+func (s *gitCatalogSource) Fetch(ctx context.Context) ([]beads.MoleculeProto, error) {
+	repoURL, ref := s.uri, ""
+	if at := strings.LastIndex(s.uri, "@"); at != -1 {
+		repoURL, ref = s.uri[:at], s.uri[at+1:]
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gt-catalog-git-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	cloneArgs := []string{"clone", "--quiet", "--depth", "1"}
+	if ref != "" {
+		cloneArgs = append(cloneArgs, "--branch", ref)
+	}
+	cloneArgs = append(cloneArgs, repoURL, tmpDir)
+	if out, err := exec.CommandContext(ctx, "git", cloneArgs...).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("git clone %s: %w: %s", repoURL, err, out)
+	}
+
+	revOut, err := exec.CommandContext(ctx, "git", "-C", tmpDir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return nil, fmt.Errorf("git rev-parse HEAD: %w", err)
+	}
+	s.resolved = strings.TrimSpace(string(revOut))
+
+	matches, err := filepath.Glob(filepath.Join(tmpDir, "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+
+	var all []beads.MoleculeProto
+	for _, f := range matches {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", f, err)
+		}
+		protos, err := parseMoleculeProtosJSONL(data, "git+"+repoURL+"@"+s.resolved)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, protos...)
+	}
+	return all, nil
+}
+
+// httpCatalogSource fetches a single URL: either a raw .jsonl file or a
+// .tar.gz bundle of them. Ref() is the response ETag when the server sends
+// one, else a content hash of the fetched bytes - there's no other
+// version concept for a plain HTTP URL.
+type httpCatalogSource struct {
+	uri      string
+	resolved string
+}
+
+func (s *httpCatalogSource) Ref() string { return s.resolved }
+
+func (s *httpCatalogSource) Fetch(ctx context.Context) ([]beads.MoleculeProto, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", s.uri, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: status %s", s.uri, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", s.uri, err)
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		s.resolved = etag
+	} else {
+		s.resolved = contentHash(string(body))
+	}
+
+	if strings.HasSuffix(s.uri, ".tar.gz") || strings.HasSuffix(s.uri, ".tgz") {
+		return parseCatalogTarball(body, s.uri)
+	}
+	return parseMoleculeProtosJSONL(body, s.uri)
+}
+
+// parseCatalogTarball reads every *.jsonl member of a gzipped tarball and
+// concatenates their protos, tagging each with sourceURI for provenance.
+func parseCatalogTarball(data []byte, sourceURI string) ([]beads.MoleculeProto, error) {
+	gz, err := gzip.NewReader(strings.NewReader(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("opening tarball: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	var all []beads.MoleculeProto
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading tarball: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg || !strings.HasSuffix(hdr.Name, ".jsonl") {
+			continue
+		}
+		contents, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s from tarball: %w", hdr.Name, err)
+		}
+		protos, err := parseMoleculeProtosJSONL(contents, sourceURI+"#"+hdr.Name)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, protos...)
+	}
+	return all, nil
+}
+
+// ociManifest is the minimal subset of an OCI image manifest needed to walk
+// its layers - there's no OCI client library available, so this talks the
+// registry HTTP v2 API directly, the same "hand-roll the one shape we need"
+// tradeoff parseTierCostsYAML makes for YAML.
+type ociManifest struct {
+	Layers []struct {
+		Digest    string `json:"digest"`
+		MediaType string `json:"mediaType"`
+	} `json:"layers"`
+}
+
+// ociCatalogSource pulls molecule protos from an OCI registry, where each
+// layer is a .jsonl blob. uri is "<registry>/<repo>:<tag>" (the oci://
+// prefix already stripped by newCatalogSource).
+type ociCatalogSource struct {
+	uri      string
+	resolved string
+}
+
+func (s *ociCatalogSource) Ref() string { return s.resolved }
+
+func (s *ociCatalogSource) Fetch(ctx context.Context) ([]beads.MoleculeProto, error) {
+	registry, repo, tag, err := splitOCIRef(s.uri)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", registry, repo, tag)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.oci.image.manifest.v1+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching manifest %s: %w", manifestURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching manifest %s: status %s", manifestURL, resp.Status)
+	}
+
+	manifestBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		s.resolved = digest
+	} else {
+		s.resolved = contentHash(string(manifestBytes))
+	}
+
+	var manifest ociManifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+
+	var all []beads.MoleculeProto
+	for _, layer := range manifest.Layers {
+		blobURL := fmt.Sprintf("https://%s/v2/%s/blobs/%s", registry, repo, layer.Digest)
+		blobReq, err := http.NewRequestWithContext(ctx, http.MethodGet, blobURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		blobResp, err := http.DefaultClient.Do(blobReq)
+		if err != nil {
+			return nil, fmt.Errorf("fetching layer %s: %w", layer.Digest, err)
+		}
+		blob, err := io.ReadAll(blobResp.Body)
+		blobResp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading layer %s: %w", layer.Digest, err)
+		}
+		protos, err := parseMoleculeProtosJSONL(blob, "oci://"+s.uri)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, protos...)
+	}
+	return all, nil
+}
+
+// splitOCIRef splits "registry/repo:tag" into its three parts, defaulting
+// tag to "latest" when omitted.
+func splitOCIRef(ref string) (registry, repo, tag string, err error) {
+	slash := strings.Index(ref, "/")
+	if slash == -1 {
+		return "", "", "", fmt.Errorf("invalid OCI ref %q: missing registry", ref)
+	}
+	registry = ref[:slash]
+	rest := ref[slash+1:]
+	tag = "latest"
+	if colon := strings.LastIndex(rest, ":"); colon != -1 {
+		repo, tag = rest[:colon], rest[colon+1:]
+	} else {
+		repo = rest
+	}
+	if repo == "" {
+		return "", "", "", fmt.Errorf("invalid OCI ref %q: missing repo", ref)
+	}
+	return registry, repo, tag, nil
+}
+
+// loadCatalogSourcesConfig parses .beads/molecules.yaml: a list of named
+// sources with type/uri/priority. Hand-rolled rather than pulling in a YAML
+// library, same as parseTierCostsYAML.
+func loadCatalogSourcesConfig(path string) ([]CatalogSourceConfig, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var sources []CatalogSourceConfig
+	var cur *CatalogSourceConfig
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "sources:" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "- ") {
+			if cur != nil {
+				sources = append(sources, *cur)
+			}
+			cur = &CatalogSourceConfig{}
+			trimmed = strings.TrimPrefix(trimmed, "- ")
+		}
+		if cur == nil {
+			continue
+		}
+		key, val, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			continue
+		}
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		switch key {
+		case "name":
+			cur.Name = val
+		case "type":
+			cur.Type = val
+		case "uri":
+			cur.URI = val
+		case "priority":
+			fmt.Sscanf(val, "%d", &cur.Priority)
+		}
+	}
+	if cur != nil {
+		sources = append(sources, *cur)
+	}
+
+	sort.SliceStable(sources, func(i, j int) bool { return sources[i].Priority > sources[j].Priority })
+	return sources, nil
+}
+
+// LockedSource is one source's resolved-and-recorded state in
+// .beads/molecules.lock.
+type LockedSource struct {
+	Name     string
+	Ref      string
+	Resolved string
+}
+
+// LockedProto is one proto's recorded provenance in the lockfile: which
+// source it came from and a content hash of its description, so attach-time
+// verification can catch a proto that changed underneath a pinned source.
+type LockedProto struct {
+	ID     string
+	Source string
+	Digest string
+}
+
+// CatalogLockfile is the parsed form of .beads/molecules.lock.
+type CatalogLockfile struct {
+	Sources []LockedSource
+	Protos  []LockedProto
+}
+
+// ProtoDigest returns the locked digest for protoID, or "" if the proto
+// isn't recorded in the lockfile (e.g. it comes from an unconfigured local
+// source, which isn't subject to lock verification).
+func (l *CatalogLockfile) ProtoDigest(protoID string) string {
+	if l == nil {
+		return ""
+	}
+	for _, p := range l.Protos {
+		if p.ID == protoID {
+			return p.Digest
+		}
+	}
+	return ""
+}
+
+// loadCatalogLockfile parses .beads/molecules.lock, hand-rolled in the same
+// indented-list style as parseDigestManifest.
+func loadCatalogLockfile(path string) (*CatalogLockfile, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &CatalogLockfile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	lock := &CatalogLockfile{}
+	section := ""
+	var curSource *LockedSource
+	var curProto *LockedProto
+
+	flush := func() {
+		if curSource != nil {
+			lock.Sources = append(lock.Sources, *curSource)
+			curSource = nil
+		}
+		if curProto != nil {
+			lock.Protos = append(lock.Protos, *curProto)
+			curProto = nil
+		}
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if trimmed == "sources:" {
+			flush()
+			section = "sources"
+			continue
+		}
+		if trimmed == "protos:" {
+			flush()
+			section = "protos"
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "- ") {
+			flush()
+			trimmed = strings.TrimPrefix(trimmed, "- ")
+			if section == "sources" {
+				curSource = &LockedSource{}
+			} else if section == "protos" {
+				curProto = &LockedProto{}
+			}
+		}
+
+		key, val, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			continue
+		}
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+
+		switch section {
+		case "sources":
+			if curSource == nil {
+				continue
+			}
+			switch key {
+			case "name":
+				curSource.Name = val
+			case "ref":
+				curSource.Ref = val
+			case "resolved":
+				curSource.Resolved = val
+			}
+		case "protos":
+			if curProto == nil {
+				continue
+			}
+			switch key {
+			case "id":
+				curProto.ID = val
+			case "source":
+				curProto.Source = val
+			case "digest":
+				curProto.Digest = val
+			}
+		}
+	}
+	flush()
+
+	return lock, nil
+}
+
+// writeCatalogLockfile renders lock back to .beads/molecules.lock.
+func writeCatalogLockfile(path string, lock *CatalogLockfile) error {
+	var b strings.Builder
+	b.WriteString("# gt molecule catalog lockfile - generated by `gt mol catalog sync`\n")
+	b.WriteString("sources:\n")
+	for _, s := range lock.Sources {
+		fmt.Fprintf(&b, "  - name: %s\n", s.Name)
+		fmt.Fprintf(&b, "    ref: %s\n", s.Ref)
+		fmt.Fprintf(&b, "    resolved: %s\n", s.Resolved)
+	}
+	b.WriteString("protos:\n")
+	for _, p := range lock.Protos {
+		fmt.Fprintf(&b, "  - id: %s\n", p.ID)
+		fmt.Fprintf(&b, "    source: %s\n", p.Source)
+		fmt.Fprintf(&b, "    digest: %s\n", p.Digest)
+	}
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// resolveCatalogSources fetches every configured source and builds the
+// lockfile that would result, plus the full proto bodies fetched (so sync
+// can cache them for the catalog to merge in later) - callers decide
+// whether to compare the lockfile against the existing one (sync without
+// --update) or write it (sync --update, or any fetch that should just
+// succeed).
+func resolveCatalogSources(ctx context.Context, configs []CatalogSourceConfig) (*CatalogLockfile, []beads.MoleculeProto, error) {
+	lock := &CatalogLockfile{}
+	var allProtos []beads.MoleculeProto
+	for _, cfg := range configs {
+		src, err := newCatalogSource(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		protos, err := src.Fetch(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("source %q: %w", cfg.Name, err)
+		}
+
+		lock.Sources = append(lock.Sources, LockedSource{Name: cfg.Name, Ref: cfg.URI, Resolved: src.Ref()})
+		for _, p := range protos {
+			lock.Protos = append(lock.Protos, LockedProto{
+				ID:     p.ID,
+				Source: cfg.Name,
+				Digest: contentHash(p.Description),
+			})
+		}
+		allProtos = append(allProtos, protos...)
+	}
+
+	sort.Slice(lock.Sources, func(i, j int) bool { return lock.Sources[i].Name < lock.Sources[j].Name })
+	sort.Slice(lock.Protos, func(i, j int) bool { return lock.Protos[i].ID < lock.Protos[j].ID })
+	return lock, allProtos, nil
+}
+
+// catalogSyncCachePath is where sync caches the full body of every proto it
+// fetched, so later catalog reads can list/show externally-sourced protos
+// without re-fetching over the network every time.
+func catalogSyncCachePath(workDir string) string {
+	return filepath.Join(workDir, ".beads", "molecules.synced.jsonl")
+}
+
+// writeCatalogSyncCache persists protos as one JSON object per line, the
+// same molecules.jsonl shape fileCatalogSource already reads.
+func writeCatalogSyncCache(path string, protos []beads.MoleculeProto) error {
+	var b strings.Builder
+	for _, p := range protos {
+		line, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// mergedCatalogProtos returns every locally-hierarchical catalog proto
+// (builtin/town/rig/project, via loadMoleculeCatalog) plus any cached from
+// the last 'gt mol catalog sync' run that aren't already present by ID -
+// local protos take precedence, the same rule runMoleculeList applies
+// between its catalog and database entries.
+func mergedCatalogProtos(workDir string) ([]beads.MoleculeProto, *CatalogLockfile, error) {
+	catalog, err := loadMoleculeCatalog(workDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	protos := catalog.List()
+
+	lock, err := loadCatalogLockfile(filepath.Join(workDir, ".beads", "molecules.lock"))
+	if err != nil {
+		return protos, nil, err
+	}
+
+	cacheData, err := os.ReadFile(catalogSyncCachePath(workDir))
+	if os.IsNotExist(err) {
+		return protos, lock, nil
+	}
+	if err != nil {
+		return protos, lock, err
+	}
+
+	synced, err := parseMoleculeProtosJSONL(cacheData, "synced")
+	if err != nil {
+		return protos, lock, err
+	}
+
+	seen := make(map[string]bool, len(protos))
+	for _, p := range protos {
+		seen[p.ID] = true
+	}
+	for _, p := range synced {
+		if seen[p.ID] {
+			continue
+		}
+		if src := lockedProtoSource(lock, p.ID); src != "" {
+			p.Source = src
+		}
+		protos = append(protos, p)
+		seen[p.ID] = true
+	}
+
+	return protos, lock, nil
+}
+
+// lockedProtoSource returns the configured source name a proto was fetched
+// from, per the lockfile, or "" if it isn't recorded there.
+func lockedProtoSource(lock *CatalogLockfile, protoID string) string {
+	if lock == nil {
+		return ""
+	}
+	for _, p := range lock.Protos {
+		if p.ID == protoID {
+			return p.Source
+		}
+	}
+	return ""
+}
+
+func runMoleculeCatalogSync(cmd *cobra.Command, args []string) error {
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+
+	configPath := filepath.Join(workDir, ".beads", "molecules.yaml")
+	lockPath := filepath.Join(workDir, ".beads", "molecules.lock")
+
+	configs, err := loadCatalogSourcesConfig(configPath)
+	if err != nil {
+		return err
+	}
+	if len(configs) == 0 {
+		fmt.Printf("%s No catalog sources configured in %s - nothing to sync\n",
+			style.Dim.Render("ℹ"), configPath)
+		return nil
+	}
+
+	resolved, protos, err := resolveCatalogSources(cmd.Context(), configs)
+	if err != nil {
+		return fmt.Errorf("resolving sources: %w", err)
+	}
+
+	existing, err := loadCatalogLockfile(lockPath)
+	if err != nil {
+		return err
+	}
+
+	if !moleculeCatalogSyncUpdate && len(existing.Protos) > 0 {
+		var drifted []string
+		existingByID := make(map[string]string, len(existing.Protos))
+		for _, p := range existing.Protos {
+			existingByID[p.ID] = p.Digest
+		}
+		for _, p := range resolved.Protos {
+			if locked, ok := existingByID[p.ID]; ok && locked != p.Digest {
+				drifted = append(drifted, p.ID)
+			}
+		}
+		if len(drifted) > 0 {
+			sort.Strings(drifted)
+			return fmt.Errorf("catalog drift detected for %s (fetch no longer matches %s) - rerun with --update to accept", strings.Join(drifted, ", "), lockPath)
+		}
+	}
+
+	if err := writeCatalogLockfile(lockPath, resolved); err != nil {
+		return fmt.Errorf("writing lockfile: %w", err)
+	}
+	if err := writeCatalogSyncCache(catalogSyncCachePath(workDir), protos); err != nil {
+		return fmt.Errorf("caching synced protos: %w", err)
+	}
+
+	if moleculeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(resolved)
+	}
+
+	fmt.Printf("%s Synced %d source(s), %d proto(s) -> %s\n",
+		style.Bold.Render("✓"), len(resolved.Sources), len(resolved.Protos), lockPath)
+	return nil
+}
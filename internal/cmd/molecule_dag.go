@@ -0,0 +1,488 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// DAGValidation is the structural result of validateMoleculeDAG: every class
+// of problem ValidateMolecule's per-step parse checks can't catch because
+// they only show up once the steps are considered as a whole graph.
+type DAGValidation struct {
+	Cycles      [][]string `json:"cycles,omitempty"`
+	MissingRefs []string   `json:"missing_refs,omitempty"`
+	Orphans     []string   `json:"orphans,omitempty"`
+
+	// UnknownOutputRefs are With: bindings whose steps.X.outputs.Y expression
+	// names a step X not in the DAG, or an output Y that X doesn't declare.
+	UnknownOutputRefs []string `json:"unknown_output_refs,omitempty"`
+
+	// ForwardOutputRefs are With: bindings that reference a step X that
+	// isn't a (transitive) Needs: dependency of the referencing step, i.e.
+	// nothing guarantees X has run - and written its outputs - first.
+	ForwardOutputRefs []string `json:"forward_output_refs,omitempty"`
+
+	// InvalidWhenRefs are When: expressions that either fail to parse or
+	// reference a step that isn't a (transitive) Needs: dependency of the
+	// referencing step - the evaluator has no way to learn that step's
+	// outcome before the referencing step would become ready.
+	InvalidWhenRefs []string `json:"invalid_when_refs,omitempty"`
+
+	// InvalidOnFailure are OnFailure: values that don't match one of
+	// fail, continue, retry:N, or skip-dependents.
+	InvalidOnFailure []string `json:"invalid_on_failure,omitempty"`
+}
+
+// Valid reports whether the DAG is structurally sound enough to instantiate.
+// Orphans are a warning (a step nobody can ever reach), not a blocker on
+// their own - they usually show up already as part of a reported cycle.
+func (v *DAGValidation) Valid() bool {
+	return v != nil && len(v.Cycles) == 0 && len(v.MissingRefs) == 0 &&
+		len(v.UnknownOutputRefs) == 0 && len(v.ForwardOutputRefs) == 0 &&
+		len(v.InvalidWhenRefs) == 0 && len(v.InvalidOnFailure) == 0
+}
+
+// validateMoleculeDAG runs a full DAG check over a parsed step list: every
+// Needs: reference must name a step in the set, the Needs graph must be
+// acyclic, and every step must be reachable from some zero-in-degree root.
+//
+// This is the "is the shape of the molecule sound" check that sits above
+// beads.ValidateMolecule's per-step parse validation - it can only be done
+// once every step is known, so it lives here rather than per-step.
+func validateMoleculeDAG(steps []beads.MoleculeStep) *DAGValidation {
+	v := &DAGValidation{}
+	meta := parseStepMeta(steps)
+
+	byRef := make(map[string]beads.MoleculeStep, len(steps))
+	for _, s := range steps {
+		byRef[s.Ref] = s
+	}
+
+	// Missing refs: a Needs: naming a step that doesn't exist.
+	missing := make(map[string]bool)
+	for _, s := range steps {
+		for _, need := range s.Needs {
+			if _, ok := byRef[need]; !ok {
+				missing[fmt.Sprintf("%s needs %s", s.Ref, need)] = true
+			}
+		}
+	}
+	for m := range missing {
+		v.MissingRefs = append(v.MissingRefs, m)
+	}
+	sort.Strings(v.MissingRefs)
+
+	// Cycle detection via three-color DFS. A back-edge to a gray node means
+	// a cycle; we report the path from that gray ancestor back to itself.
+	const (
+		white = 0
+		gray  = 1
+		black = 2
+	)
+	color := make(map[string]int, len(steps))
+	var path []string
+	var cycles [][]string
+
+	var visit func(ref string)
+	visit = func(ref string) {
+		color[ref] = gray
+		path = append(path, ref)
+
+		if s, ok := byRef[ref]; ok {
+			for _, need := range s.Needs {
+				if _, ok := byRef[need]; !ok {
+					continue // already reported as a missing ref
+				}
+				switch color[need] {
+				case white:
+					visit(need)
+				case gray:
+					cycles = append(cycles, cyclePath(path, need))
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		color[ref] = black
+	}
+
+	for _, s := range steps {
+		if color[s.Ref] == white {
+			visit(s.Ref)
+		}
+	}
+	v.Cycles = cycles
+
+	// Orphans: steps unreachable from any zero-in-degree root, walking
+	// forward along Needs -> dependent edges.
+	reachable := make(map[string]bool, len(steps))
+	var roots []string
+	for _, s := range steps {
+		if len(s.Needs) == 0 {
+			roots = append(roots, s.Ref)
+		}
+	}
+	forward := make(map[string][]string) // need -> [steps that need it]
+	for _, s := range steps {
+		for _, need := range s.Needs {
+			forward[need] = append(forward[need], s.Ref)
+		}
+	}
+	var walk func(ref string)
+	walk = func(ref string) {
+		if reachable[ref] {
+			return
+		}
+		reachable[ref] = true
+		for _, next := range forward[ref] {
+			walk(next)
+		}
+	}
+	for _, root := range roots {
+		walk(root)
+	}
+	for _, s := range steps {
+		if !reachable[s.Ref] {
+			v.Orphans = append(v.Orphans, s.Ref)
+		}
+	}
+	sort.Strings(v.Orphans)
+
+	ancestors := computeAncestors(steps, byRef)
+	v.UnknownOutputRefs, v.ForwardOutputRefs = validateStepOutputRefs(steps, byRef, ancestors, meta)
+	v.InvalidWhenRefs, v.InvalidOnFailure = validateStepConditions(steps, ancestors, meta)
+
+	return v
+}
+
+// computeAncestors returns, for every step ref, the set of refs reachable by
+// following Needs: transitively - i.e. every step guaranteed to have closed
+// before that step becomes ready. Shared by validateStepOutputRefs (a
+// steps.X.outputs.Y binding needs X to be an ancestor) and
+// validateStepConditions (a When: reference needs the same guarantee).
+func computeAncestors(steps []beads.MoleculeStep, byRef map[string]beads.MoleculeStep) map[string]map[string]bool {
+	ancestors := make(map[string]map[string]bool, len(steps))
+	var ancestorsOf func(ref string) map[string]bool
+	ancestorsOf = func(ref string) map[string]bool {
+		if a, ok := ancestors[ref]; ok {
+			return a
+		}
+		a := make(map[string]bool)
+		ancestors[ref] = a // pre-seed so a cycle (reported separately) can't recurse forever
+		if s, ok := byRef[ref]; ok {
+			for _, need := range s.Needs {
+				a[need] = true
+				for anc := range ancestorsOf(need) {
+					a[anc] = true
+				}
+			}
+		}
+		return a
+	}
+
+	for _, s := range steps {
+		ancestorsOf(s.Ref)
+	}
+	return ancestors
+}
+
+// moleculeTargetClosure returns the set of step refs required to produce
+// targets: each target itself plus every step it transitively Needs:. A
+// step outside this set contributes nothing any target depends on, so it's
+// skipped rather than instantiated. An empty targets list means every step
+// is required (the pre-target-support default), so the closure is every ref.
+func moleculeTargetClosure(steps []beads.MoleculeStep, targets []string) map[string]bool {
+	required := make(map[string]bool, len(steps))
+	if len(targets) == 0 {
+		for _, s := range steps {
+			required[s.Ref] = true
+		}
+		return required
+	}
+
+	byRef := make(map[string]beads.MoleculeStep, len(steps))
+	for _, s := range steps {
+		byRef[s.Ref] = s
+	}
+	ancestors := computeAncestors(steps, byRef)
+
+	for _, t := range targets {
+		required[t] = true
+		for anc := range ancestors[t] {
+			required[anc] = true
+		}
+	}
+	return required
+}
+
+// outputRefPattern matches a With: expression that reads an upstream step's
+// declared output, e.g. "steps.fetch.outputs.url".
+var outputRefPattern = regexp.MustCompile(`^steps\.([^.]+)\.outputs\.([^.]+)$`)
+
+// validateStepOutputRefs checks every step's With: bindings: each
+// steps.X.outputs.Y expression must name a step X in the DAG that declares
+// Y as one of its Outputs:, and X must be a (transitive) Needs: dependency
+// of the referencing step - otherwise nothing orders X's close, and its
+// output write, before the reference is resolved.
+func validateStepOutputRefs(steps []beads.MoleculeStep, byRef map[string]beads.MoleculeStep, ancestors map[string]map[string]bool, meta map[string]stepMeta) (unknown, forward []string) {
+	for _, s := range steps {
+		for varName, expr := range meta[s.Ref].With {
+			m := outputRefPattern.FindStringSubmatch(expr)
+			if m == nil {
+				continue // not a steps.X.outputs.Y expression
+			}
+			upstreamRef, outputKey := m[1], m[2]
+
+			_, ok := byRef[upstreamRef]
+			if !ok || !declaresOutput(meta[upstreamRef], outputKey) {
+				unknown = append(unknown, fmt.Sprintf("%s.with.%s -> %s", s.Ref, varName, expr))
+				continue
+			}
+
+			if !ancestors[s.Ref][upstreamRef] {
+				forward = append(forward, fmt.Sprintf("%s.with.%s -> %s (%s is not a dependency of %s)", s.Ref, varName, expr, upstreamRef, s.Ref))
+			}
+		}
+	}
+
+	sort.Strings(unknown)
+	sort.Strings(forward)
+	return unknown, forward
+}
+
+// validateStepConditions checks every step's When: and OnFailure: values:
+// a When: must parse (see parseWhenExpr) and name a (transitive) Needs:
+// dependency, the same guarantee validateStepOutputRefs requires of a
+// With: output binding - the evaluator can't observe a step's outcome
+// before that step is guaranteed to have run. An OnFailure: must parse via
+// parseOnFailurePolicy.
+func validateStepConditions(steps []beads.MoleculeStep, ancestors map[string]map[string]bool, meta map[string]stepMeta) (invalidWhen, invalidOnFailure []string) {
+	for _, s := range steps {
+		m := meta[s.Ref]
+		if m.When != "" {
+			parsed, err := parseWhenExpr(m.When)
+			switch {
+			case err != nil:
+				invalidWhen = append(invalidWhen, fmt.Sprintf("%s.when %q: %v", s.Ref, m.When, err))
+			case !ancestors[s.Ref][parsed.Ref]:
+				invalidWhen = append(invalidWhen, fmt.Sprintf("%s.when %q: %s is not a dependency of %s", s.Ref, m.When, parsed.Ref, s.Ref))
+			}
+		}
+
+		if m.OnFailure != "" {
+			if _, err := parseOnFailurePolicy(m.OnFailure); err != nil {
+				invalidOnFailure = append(invalidOnFailure, fmt.Sprintf("%s.on_failure %q: %v", s.Ref, m.OnFailure, err))
+			}
+		}
+	}
+
+	sort.Strings(invalidWhen)
+	sort.Strings(invalidOnFailure)
+	return invalidWhen, invalidOnFailure
+}
+
+// declaresOutput reports whether m declares key among its Outputs:.
+func declaresOutput(m stepMeta, key string) bool {
+	for _, o := range m.Outputs {
+		if o == key {
+			return true
+		}
+	}
+	return false
+}
+
+// cyclePath trims path down to the loop itself: from gray's first
+// occurrence back around to gray again.
+func cyclePath(path []string, gray string) []string {
+	for i, ref := range path {
+		if ref == gray {
+			loop := append([]string{}, path[i:]...)
+			return append(loop, gray)
+		}
+	}
+	return append(append([]string{}, path...), gray)
+}
+
+// printDAGValidation prints each class of DAG error distinctly, matching
+// the per-class reporting 'gt molecule parse' gives structural parse
+// errors.
+func printDAGValidation(v *DAGValidation) {
+	if v.Valid() && len(v.Orphans) == 0 {
+		return
+	}
+
+	if len(v.MissingRefs) > 0 {
+		fmt.Printf("\nMissing refs:\n")
+		for _, m := range v.MissingRefs {
+			fmt.Printf("  - %s\n", m)
+		}
+	}
+
+	if len(v.Cycles) > 0 {
+		fmt.Printf("\nCycles:\n")
+		for _, cycle := range v.Cycles {
+			fmt.Printf("  - %s\n", strings.Join(cycle, " -> "))
+		}
+	}
+
+	if len(v.Orphans) > 0 {
+		fmt.Printf("\nOrphan steps (unreachable from any root):\n")
+		for _, o := range v.Orphans {
+			fmt.Printf("  - %s\n", o)
+		}
+	}
+
+	if len(v.UnknownOutputRefs) > 0 {
+		fmt.Printf("\nUnknown output refs:\n")
+		for _, r := range v.UnknownOutputRefs {
+			fmt.Printf("  - %s\n", r)
+		}
+	}
+
+	if len(v.ForwardOutputRefs) > 0 {
+		fmt.Printf("\nForward output refs (reference a step that isn't a dependency):\n")
+		for _, r := range v.ForwardOutputRefs {
+			fmt.Printf("  - %s\n", r)
+		}
+	}
+
+	if len(v.InvalidWhenRefs) > 0 {
+		fmt.Printf("\nInvalid When: expressions:\n")
+		for _, r := range v.InvalidWhenRefs {
+			fmt.Printf("  - %s\n", r)
+		}
+	}
+
+	if len(v.InvalidOnFailure) > 0 {
+		fmt.Printf("\nInvalid OnFailure: values:\n")
+		for _, r := range v.InvalidOnFailure {
+			fmt.Printf("  - %s\n", r)
+		}
+	}
+}
+
+// dryRunPlan previews a molecule's steps as child beads and Needs: edges
+// after --context substitution, the same way 'gt molecule instantiate'
+// actually creates them. It also annotates each step's With:/When:/
+// OnFailure: directives, but those are preview-only: today's instantiator
+// doesn't resolve output bindings, gate on When:, or spawn retries, so
+// this is a preview of what those directives declare, not of behavior
+// 'gt molecule instantiate' performs.
+type dryRunPlan struct {
+	Beads []dryRunBead `json:"beads"`
+	Edges []dryRunEdge `json:"edges"`
+}
+
+type dryRunBead struct {
+	Ref   string `json:"ref"`
+	Title string `json:"title"`
+
+	// Bindings is the step's With:, left unresolved here - a
+	// steps.X.outputs.Y expression can only be rendered once X closes and
+	// attaches its output, which would require instantiation to defer and
+	// later resolve the binding. It's recorded as a pending binding rather
+	// than substituted, but note that 'gt molecule instantiate' doesn't
+	// implement that deferred resolution today; this field previews what
+	// the step declares, not what gets created.
+	Bindings map[string]string `json:"bindings,omitempty"`
+
+	// InitialStatus would be "pending-condition" when the step has a
+	// When:, if instantiation gated child beads on their When: condition.
+	// It doesn't today - 'gt molecule instantiate' creates every child in
+	// its usual open/blocked status regardless of When: - so this previews
+	// what a conditional instantiator would need to do, not current
+	// behavior.
+	InitialStatus string `json:"initial_status,omitempty"`
+	When          string `json:"when,omitempty"`
+	OnFailure     string `json:"on_failure,omitempty"`
+}
+
+type dryRunEdge struct {
+	From string `json:"from"` // step ref this edge depends on
+	To   string `json:"to"`   // step ref that needs From
+}
+
+// planDryRunInstantiate renders the child beads and edges 'gt molecule
+// instantiate' actually creates for steps given ctx (Needs: wiring and
+// {{variable}} substitution), without creating anything, plus a preview
+// of each step's With:/When:/OnFailure: directives that instantiation
+// does not yet act on - see dryRunBead's field comments.
+func planDryRunInstantiate(steps []beads.MoleculeStep, ctx map[string]string) *dryRunPlan {
+	plan := &dryRunPlan{}
+	meta := parseStepMeta(steps)
+	for _, s := range steps {
+		m := meta[s.Ref]
+		initialStatus := ""
+		if m.When != "" {
+			initialStatus = "pending-condition"
+		}
+		plan.Beads = append(plan.Beads, dryRunBead{
+			Ref:           s.Ref,
+			Title:         substituteContext(s.Title, ctx),
+			Bindings:      m.With,
+			InitialStatus: initialStatus,
+			When:          m.When,
+			OnFailure:     m.OnFailure,
+		})
+		for _, need := range s.Needs {
+			plan.Edges = append(plan.Edges, dryRunEdge{From: need, To: s.Ref})
+		}
+	}
+	return plan
+}
+
+// parseContextFlags parses a list of "key=value" --context flags into a
+// context map, shared by 'gt molecule instantiate' and 'gt molecule parse
+// --dry-run' so they reject malformed flags the same way.
+func parseContextFlags(flags []string) (map[string]string, error) {
+	ctx := make(map[string]string)
+	for _, kv := range flags {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid context format %q (expected key=value)", kv)
+		}
+		ctx[parts[0]] = parts[1]
+	}
+	return ctx, nil
+}
+
+// substituteContext replaces {{key}} placeholders with ctx values, leaving
+// any placeholder without a matching key untouched so it's still visible
+// in a --dry-run render. ctx only ever holds --context flag values, so a
+// steps.X.outputs.Y reference (which lives in With:, not a {{...}}
+// placeholder in Title) is never a candidate here - those are a separate,
+// unimplemented form of binding (see dryRunBead.Bindings).
+func substituteContext(s string, ctx map[string]string) string {
+	for k, v := range ctx {
+		s = strings.ReplaceAll(s, "{{"+k+"}}", v)
+	}
+	return s
+}
+
+func printDryRunPlan(plan *dryRunPlan) {
+	fmt.Printf("\nWould create %d bead(s):\n", len(plan.Beads))
+	for _, b := range plan.Beads {
+		fmt.Printf("  %s: %s\n", style.Bold.Render(b.Ref), b.Title)
+		for varName, expr := range b.Bindings {
+			fmt.Printf("      with %s = %s (not resolved by instantiate today)\n", varName, expr)
+		}
+		if b.When != "" {
+			fmt.Printf("      when %s (not gated by instantiate today)\n", b.When)
+		}
+		if b.OnFailure != "" {
+			fmt.Printf("      on_failure %s (not acted on by instantiate today)\n", b.OnFailure)
+		}
+	}
+
+	if len(plan.Edges) > 0 {
+		fmt.Printf("\nWould wire %d edge(s):\n", len(plan.Edges))
+		for _, e := range plan.Edges {
+			fmt.Printf("  %s -> %s\n", e.From, e.To)
+		}
+	}
+}
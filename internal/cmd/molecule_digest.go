@@ -0,0 +1,452 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// DigestStepRecord is one step's SBOM-style entry in a digest manifest.
+type DigestStepRecord struct {
+	ID           string   `json:"id"`
+	Title        string   `json:"title"`
+	Status       string   `json:"status"`
+	StartedAt    string   `json:"started_at,omitempty"`
+	ClosedAt     string   `json:"closed_at,omitempty"`
+	Duration     string   `json:"duration,omitempty"`
+	Dependencies []string `json:"dependencies,omitempty"`
+	ClosedBy     string   `json:"closed_by,omitempty"`
+}
+
+// DigestManifest is the structured, machine-parseable record 'gt mol squash'
+// writes as a digest bead's description - a YAML front-matter block (parsed
+// by parseDigestManifest) followed by a human-readable Markdown body, so a
+// squashed molecule is an auditable execution record rather than a free-text
+// summary.
+type DigestManifest struct {
+	MoleculeID       string             `json:"molecule_id"`
+	MoleculeVersion  string             `json:"molecule_version"`
+	InstanceRoot     string             `json:"instance_root"`
+	Agent            string             `json:"agent"`
+	StartedAt        string             `json:"started_at,omitempty"`
+	EndedAt          string             `json:"ended_at,omitempty"`
+	CompletionStatus string             `json:"status"`
+	ContentHash      string             `json:"content_hash"`
+	Steps            []DigestStepRecord `json:"steps,omitempty"`
+	ChangedFiles     []string           `json:"changed_files,omitempty"`
+}
+
+// Molecule digest command group.
+var moleculeDigestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Inspect squashed molecule digest manifests",
+}
+
+var moleculeDigestShowCmd = &cobra.Command{
+	Use:   "show <digest-id>",
+	Short: "Render a digest bead's manifest",
+	Long: `Parse and render the SBOM-style manifest 'gt mol squash' wrote into a
+digest bead's description: molecule proto + version, instance root, agent,
+wall-clock start/end, per-step records, aggregated changed files, and the
+content hash over the step-record list.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMoleculeDigestShow,
+}
+
+func init() {
+	moleculeDigestShowCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output the manifest as JSON")
+	moleculeDigestCmd.AddCommand(moleculeDigestShowCmd)
+	moleculeCmd.AddCommand(moleculeDigestCmd)
+}
+
+func runMoleculeDigestShow(cmd *cobra.Command, args []string) error {
+	digestID := args[0]
+
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+	b := beads.New(workDir)
+
+	digest, err := b.Show(digestID)
+	if err != nil {
+		return fmt.Errorf("getting digest: %w", err)
+	}
+
+	manifest, err := parseDigestManifest(digest.Description)
+	if err != nil {
+		return fmt.Errorf("parsing digest manifest: %w", err)
+	}
+
+	if moleculeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(manifest)
+	}
+
+	printDigestManifest(digestID, manifest)
+	return nil
+}
+
+func printDigestManifest(digestID string, m *DigestManifest) {
+	fmt.Printf("\n%s %s\n\n", style.Bold.Render("📦 Digest:"), digestID)
+	fmt.Printf("  Molecule: %s (%s)\n", m.MoleculeID, m.MoleculeVersion)
+	fmt.Printf("  Instance: %s\n", m.InstanceRoot)
+	fmt.Printf("  Agent:    %s\n", m.Agent)
+	if m.StartedAt != "" || m.EndedAt != "" {
+		fmt.Printf("  Ran:      %s -> %s\n", m.StartedAt, m.EndedAt)
+	}
+	fmt.Printf("  Status:   %s\n", m.CompletionStatus)
+	fmt.Printf("  Hash:     %s\n", m.ContentHash)
+
+	if len(m.Steps) > 0 {
+		fmt.Printf("\n  Steps:\n")
+		for _, s := range m.Steps {
+			fmt.Printf("    %-12s %-8s %s", s.ID, s.Status, s.Title)
+			if s.Duration != "" {
+				fmt.Printf(" %s", style.Dim.Render("("+s.Duration+")"))
+			}
+			fmt.Println()
+		}
+	}
+
+	if len(m.ChangedFiles) > 0 {
+		fmt.Printf("\n  Changed files:\n")
+		for _, f := range m.ChangedFiles {
+			fmt.Printf("    - %s\n", f)
+		}
+	}
+}
+
+// buildDigestManifest assembles a DigestManifest from an instantiated
+// molecule's children: per-step records, aggregated changed_files, and a
+// content hash over the sorted step-record list so two digests of the same
+// run compare equal regardless of bead-listing order. The proto molecule ID
+// is recovered from a child's "instantiated_from:" field (same as
+// getMoleculeProgressInfo's MoleculeID lookup) rather than passed in, since
+// that's the only place it's recorded once instantiation has run.
+func buildDigestManifest(b *beads.Beads, instanceRoot, agent string) (*DigestManifest, error) {
+	children, err := b.List(beads.ListOptions{Parent: instanceRoot, Status: "all", Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing children: %w", err)
+	}
+
+	moleculeID := ""
+	for _, c := range children {
+		if molID := extractMoleculeID(c.Description); molID != "" {
+			moleculeID = molID
+			break
+		}
+	}
+
+	version := ""
+	if moleculeID != "" {
+		if proto, err := b.Show(moleculeID); err == nil {
+			version = contentHash(proto.Description)
+		}
+	}
+
+	changedFilesSeen := make(map[string]bool)
+	var changedFiles []string
+	steps := make([]DigestStepRecord, 0, len(children))
+	startedAt, endedAt := "", ""
+	allClosed := len(children) > 0
+
+	for _, c := range children {
+		rec := DigestStepRecord{
+			ID:           c.ID,
+			Title:        c.Title,
+			Status:       c.Status,
+			StartedAt:    c.CreatedAt,
+			Dependencies: c.DependsOn,
+			ClosedBy:     c.Assignee,
+		}
+
+		if c.Status == "closed" {
+			rec.ClosedAt = c.UpdatedAt
+			if rec.StartedAt != "" && rec.ClosedAt != "" {
+				if st, errSt := time.Parse(time.RFC3339, rec.StartedAt); errSt == nil {
+					if en, errEn := time.Parse(time.RFC3339, rec.ClosedAt); errEn == nil {
+						rec.Duration = en.Sub(st).Round(time.Second).String()
+					}
+				}
+			}
+			if rec.ClosedAt != "" && (endedAt == "" || rec.ClosedAt > endedAt) {
+				endedAt = rec.ClosedAt
+			}
+		} else {
+			allClosed = false
+		}
+
+		if rec.StartedAt != "" && (startedAt == "" || rec.StartedAt < startedAt) {
+			startedAt = rec.StartedAt
+		}
+
+		for _, f := range extractChangedFiles(c.Description) {
+			if !changedFilesSeen[f] {
+				changedFilesSeen[f] = true
+				changedFiles = append(changedFiles, f)
+			}
+		}
+
+		steps = append(steps, rec)
+	}
+
+	sort.Slice(steps, func(i, j int) bool { return steps[i].ID < steps[j].ID })
+	sort.Strings(changedFiles)
+
+	status := "partial"
+	if allClosed {
+		status = "complete"
+	}
+
+	return &DigestManifest{
+		MoleculeID:       moleculeID,
+		MoleculeVersion:  version,
+		InstanceRoot:     instanceRoot,
+		Agent:            agent,
+		StartedAt:        startedAt,
+		EndedAt:          endedAt,
+		CompletionStatus: status,
+		ContentHash:      stepRecordsHash(steps),
+		Steps:            steps,
+		ChangedFiles:     changedFiles,
+	}, nil
+}
+
+// contentHash returns a "sha256:<hex>" digest of s, used both for a
+// molecule proto's version hash and (via stepRecordsHash) a manifest's
+// content hash.
+func contentHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// stepRecordsHash hashes steps (already sorted by ID) into a single content
+// hash: any change to a step's recorded outcome changes the digest.
+func stepRecordsHash(steps []DigestStepRecord) string {
+	var b strings.Builder
+	for _, s := range steps {
+		fmt.Fprintf(&b, "%s|%s|%s|%s|%s|%s|%s|%s\n",
+			s.ID, s.Title, s.Status, s.StartedAt, s.ClosedAt, s.Duration,
+			strings.Join(s.Dependencies, ","), s.ClosedBy)
+	}
+	return contentHash(b.String())
+}
+
+// extractChangedFiles scrapes a child bead's optional "changed_files:" line
+// - a comma-separated list of paths the step touched - the same
+// description-field scraping convention as extractMoleculeID/extractStepRef.
+func extractChangedFiles(description string) []string {
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "changed_files:") {
+			continue
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(line, "changed_files:"))
+		if rest == "" {
+			return nil
+		}
+		var files []string
+		for _, f := range strings.Split(rest, ",") {
+			if f = strings.TrimSpace(f); f != "" {
+				files = append(files, f)
+			}
+		}
+		return files
+	}
+	return nil
+}
+
+// renderDigestManifest serializes m as YAML front-matter followed by a
+// short Markdown summary, the format parseDigestManifest reads back.
+func renderDigestManifest(m *DigestManifest) string {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "molecule_id: %s\n", m.MoleculeID)
+	fmt.Fprintf(&b, "molecule_version: %s\n", m.MoleculeVersion)
+	fmt.Fprintf(&b, "instance_root: %s\n", m.InstanceRoot)
+	fmt.Fprintf(&b, "agent: %s\n", m.Agent)
+	if m.StartedAt != "" {
+		fmt.Fprintf(&b, "started_at: %s\n", m.StartedAt)
+	}
+	if m.EndedAt != "" {
+		fmt.Fprintf(&b, "ended_at: %s\n", m.EndedAt)
+	}
+	fmt.Fprintf(&b, "status: %s\n", m.CompletionStatus)
+	fmt.Fprintf(&b, "content_hash: %s\n", m.ContentHash)
+
+	if len(m.Steps) > 0 {
+		b.WriteString("steps:\n")
+		for _, s := range m.Steps {
+			fmt.Fprintf(&b, "  - id: %s\n", s.ID)
+			fmt.Fprintf(&b, "    title: %s\n", s.Title)
+			fmt.Fprintf(&b, "    status: %s\n", s.Status)
+			if s.StartedAt != "" {
+				fmt.Fprintf(&b, "    started_at: %s\n", s.StartedAt)
+			}
+			if s.ClosedAt != "" {
+				fmt.Fprintf(&b, "    closed_at: %s\n", s.ClosedAt)
+			}
+			if s.Duration != "" {
+				fmt.Fprintf(&b, "    duration: %s\n", s.Duration)
+			}
+			if len(s.Dependencies) > 0 {
+				fmt.Fprintf(&b, "    dependencies: %s\n", strings.Join(s.Dependencies, ","))
+			}
+			if s.ClosedBy != "" {
+				fmt.Fprintf(&b, "    closed_by: %s\n", s.ClosedBy)
+			}
+		}
+	}
+
+	if len(m.ChangedFiles) > 0 {
+		b.WriteString("changed_files:\n")
+		for _, f := range m.ChangedFiles {
+			fmt.Fprintf(&b, "  - %s\n", f)
+		}
+	}
+
+	b.WriteString("---\n\n")
+	fmt.Fprintf(&b, "# Digest: %s\n\n", m.MoleculeID)
+	fmt.Fprintf(&b, "Squashed by %s. %d step(s), status %s.\n", m.Agent, len(m.Steps), m.CompletionStatus)
+	if len(m.ChangedFiles) > 0 {
+		fmt.Fprintf(&b, "\n%d file(s) changed across this run.\n", len(m.ChangedFiles))
+	}
+
+	return b.String()
+}
+
+// parseDigestManifest parses the YAML front-matter renderDigestManifest
+// writes back into a DigestManifest. Hand-rolled rather than pulling in a
+// YAML library, the same tradeoff parseTierCostsYAML makes, since this is
+// the one fixed shape renderDigestManifest ever produces.
+func parseDigestManifest(description string) (*DigestManifest, error) {
+	lines := strings.Split(description, "\n")
+
+	i := 0
+	for i < len(lines) && strings.TrimSpace(lines[i]) != "---" {
+		i++
+	}
+	if i == len(lines) {
+		return nil, fmt.Errorf("no YAML front-matter found")
+	}
+	i++
+
+	m := &DigestManifest{}
+	var curStep *DigestStepRecord
+	section := ""
+
+	flushStep := func() {
+		if curStep != nil {
+			m.Steps = append(m.Steps, *curStep)
+			curStep = nil
+		}
+	}
+
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "---" {
+			break
+		}
+		if trimmed == "" {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		if indent == 0 {
+			flushStep()
+			switch trimmed {
+			case "steps:":
+				section = "steps"
+				continue
+			case "changed_files:":
+				section = "changed_files"
+				continue
+			}
+			section = ""
+
+			key, val, ok := strings.Cut(trimmed, ":")
+			if !ok {
+				continue
+			}
+			key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+			switch key {
+			case "molecule_id":
+				m.MoleculeID = val
+			case "molecule_version":
+				m.MoleculeVersion = val
+			case "instance_root":
+				m.InstanceRoot = val
+			case "agent":
+				m.Agent = val
+			case "started_at":
+				m.StartedAt = val
+			case "ended_at":
+				m.EndedAt = val
+			case "status":
+				m.CompletionStatus = val
+			case "content_hash":
+				m.ContentHash = val
+			}
+			continue
+		}
+
+		switch {
+		case section == "changed_files" && strings.HasPrefix(trimmed, "-"):
+			m.ChangedFiles = append(m.ChangedFiles, strings.TrimSpace(strings.TrimPrefix(trimmed, "-")))
+		case section == "steps" && strings.HasPrefix(trimmed, "-"):
+			flushStep()
+			curStep = &DigestStepRecord{}
+			applyDigestStepField(curStep, strings.TrimSpace(strings.TrimPrefix(trimmed, "-")))
+		case section == "steps" && curStep != nil:
+			applyDigestStepField(curStep, trimmed)
+		}
+	}
+	flushStep()
+
+	return m, nil
+}
+
+// applyDigestStepField parses one "key: value" line from a steps: entry
+// into the matching DigestStepRecord field.
+func applyDigestStepField(step *DigestStepRecord, kv string) {
+	key, val, ok := strings.Cut(kv, ":")
+	if !ok {
+		return
+	}
+	key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+
+	switch key {
+	case "id":
+		step.ID = val
+	case "title":
+		step.Title = val
+	case "status":
+		step.Status = val
+	case "started_at":
+		step.StartedAt = val
+	case "closed_at":
+		step.ClosedAt = val
+	case "duration":
+		step.Duration = val
+	case "dependencies":
+		if val != "" {
+			step.Dependencies = strings.Split(val, ",")
+		}
+	case "closed_by":
+		step.ClosedBy = val
+	}
+}
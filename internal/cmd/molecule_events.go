@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// Molecule events command flags.
+var (
+	moleculeEventsInterval time.Duration
+	moleculeEventsSince    string
+)
+
+var moleculeEventsCmd = &cobra.Command{
+	Use:   "events <root-id>",
+	Short: "Stream a molecule's step transition events",
+	Long: `Stream step_ready/step_started/step_closed/step_blocked/molecule_complete
+events for an instantiated molecule, so a hook or external orchestrator can
+react to transitions instead of polling 'gt mol progress'.
+
+Synthesized by polling the instance's children every --interval (default
+2s, also used as the debounce window a burst of near-simultaneous writes is
+coalesced into) and diffing successive snapshots - there's no separate
+notification path to watch, so this is the same poll-and-diff approach 'gt
+mol watch' uses for its progress bar, just emitting the transitions instead
+of rendering them.
+
+Use --json for NDJSON output (one event per line) so a hook can pipe the
+stream. Use --since <RFC3339 timestamp> to replay past transitions from
+issue history instead of watching live ones.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMoleculeEvents,
+}
+
+func init() {
+	moleculeEventsCmd.Flags().DurationVar(&moleculeEventsInterval, "interval", 2*time.Second, "Poll interval (also the debounce window)")
+	moleculeEventsCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Emit NDJSON, one event per line")
+	moleculeEventsCmd.Flags().StringVar(&moleculeEventsSince, "since", "", "Replay events since this RFC3339 timestamp instead of watching live")
+	moleculeCmd.AddCommand(moleculeEventsCmd)
+}
+
+// MoleculeEvent is one step (or whole-molecule) state transition, synthesized
+// by diffing successive progress snapshots - see subscribeMoleculeEvents.
+type MoleculeEvent struct {
+	Type       string                `json:"type"` // step_ready, step_started, step_closed, step_blocked, molecule_complete
+	StepID     string                `json:"step_id,omitempty"`
+	PrevStatus string                `json:"prev_status,omitempty"`
+	NewStatus  string                `json:"new_status,omitempty"`
+	Timestamp  time.Time             `json:"timestamp"`
+	Progress   *MoleculeProgressInfo `json:"progress,omitempty"`
+}
+
+func runMoleculeEvents(cmd *cobra.Command, args []string) error {
+	rootID := args[0]
+
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+	b := beads.New(workDir)
+
+	if moleculeEventsSince != "" {
+		since, err := time.Parse(time.RFC3339, moleculeEventsSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since %q: %w", moleculeEventsSince, err)
+		}
+		events, err := replayMoleculeEvents(b, rootID, since)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			printMoleculeEvent(ev)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	events, err := subscribeMoleculeEvents(ctx, b, workDir, rootID, moleculeEventsInterval)
+	if err != nil {
+		return err
+	}
+
+	for ev := range events {
+		printMoleculeEvent(ev)
+		if ev.Type == "molecule_complete" {
+			cancel()
+		}
+	}
+	return nil
+}
+
+func printMoleculeEvent(ev MoleculeEvent) {
+	if moleculeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.Encode(ev)
+		return
+	}
+
+	if ev.StepID != "" {
+		fmt.Printf("%s %s %s -> %s (%s)\n", ev.Timestamp.Format(time.RFC3339), ev.Type, ev.StepID, ev.NewStatus, ev.PrevStatus)
+	} else {
+		fmt.Printf("%s %s\n", ev.Timestamp.Format(time.RFC3339), ev.Type)
+	}
+}
+
+// subscribeMoleculeEvents polls rootID's children every interval and diffs
+// successive snapshots into a stream of MoleculeEvent. This lives here
+// rather than as beads.Beads.SubscribeMolecule - the diffing only needs
+// b.List and the same status-classification rules getMoleculeProgressInfo
+// already applies, so there's no reason to duplicate that logic behind a
+// new beads-level API. Closing ctx stops the poll loop and closes the
+// returned channel.
+func subscribeMoleculeEvents(ctx context.Context, b *beads.Beads, workDir, rootID string, interval time.Duration) (<-chan MoleculeEvent, error) {
+	if _, err := b.Show(rootID); err != nil {
+		return nil, fmt.Errorf("getting root issue: %w", err)
+	}
+
+	out := make(chan MoleculeEvent)
+	go func() {
+		defer close(out)
+
+		prevStatus := make(map[string]string)
+		wasComplete := false
+		first := true
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			children, err := b.List(beads.ListOptions{Parent: rootID, Status: "all", Priority: -1})
+			if err == nil {
+				closedIDs := make(map[string]bool, len(children))
+				for _, c := range children {
+					if c.Status == "closed" {
+						closedIDs[c.ID] = true
+					}
+				}
+
+				sort.Slice(children, func(i, j int) bool { return children[i].ID < children[j].ID })
+				now := time.Now()
+
+				for _, c := range children {
+					ready := len(c.DependsOn) == 0
+					if !ready {
+						ready = true
+						for _, depID := range c.DependsOn {
+							if !closedIDs[depID] {
+								ready = false
+								break
+							}
+						}
+					}
+
+					effective := c.Status
+					if c.Status == "open" {
+						if ready {
+							effective = "ready"
+						} else {
+							effective = "blocked"
+						}
+					}
+
+					prev, seen := prevStatus[c.ID]
+					prevStatus[c.ID] = effective
+					if !seen || prev == effective || first {
+						continue
+					}
+
+					ev := MoleculeEvent{StepID: c.ID, PrevStatus: prev, NewStatus: effective, Timestamp: now}
+					switch effective {
+					case "ready":
+						ev.Type = "step_ready"
+					case "blocked":
+						ev.Type = "step_blocked"
+					case "in_progress":
+						ev.Type = "step_started"
+					case "closed":
+						ev.Type = "step_closed"
+					default:
+						continue
+					}
+
+					select {
+					case out <- ev:
+					case <-ctx.Done():
+						return
+					}
+				}
+
+				if progress, err := getMoleculeProgressInfo(b, workDir, rootID); err == nil && progress != nil {
+					if progress.Complete && !wasComplete && !first {
+						select {
+						case out <- MoleculeEvent{Type: "molecule_complete", Timestamp: now, Progress: progress}:
+						case <-ctx.Done():
+							return
+						}
+					}
+					wasComplete = progress.Complete
+				}
+
+				first = false
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// replayMoleculeEvents reconstructs past step transitions from issue
+// history rather than watching live ones, for 'gt mol events --since'.
+// Relies on beads.Beads.IssueHistory (one entry per field change, the same
+// shape the DB already keeps for 'bd log') since that history - not a
+// second live diff pass - is the only source of events before now.
+func replayMoleculeEvents(b *beads.Beads, rootID string, since time.Time) ([]MoleculeEvent, error) {
+	children, err := b.List(beads.ListOptions{Parent: rootID, Status: "all", Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing children: %w", err)
+	}
+
+	var events []MoleculeEvent
+	for _, c := range children {
+		changes, err := b.IssueHistory(c.ID, since)
+		if err != nil {
+			continue // no history available for this child - skip rather than fail the whole replay
+		}
+		for _, change := range changes {
+			if change.Field != "status" {
+				continue
+			}
+			ev := MoleculeEvent{StepID: c.ID, PrevStatus: change.OldValue, NewStatus: change.NewValue, Timestamp: change.At}
+			switch change.NewValue {
+			case "in_progress":
+				ev.Type = "step_started"
+			case "closed":
+				ev.Type = "step_closed"
+			default:
+				continue
+			}
+			events = append(events, ev)
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool { return events[i].Timestamp.Before(events[j].Timestamp) })
+	return events, nil
+}
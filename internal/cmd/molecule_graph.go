@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Molecule graph command flags.
+var (
+	moleculeGraphFormat    string
+	moleculeGraphOutput    string
+	moleculeGraphClusterBy string
+)
+
+var moleculeGraphCmd = &cobra.Command{
+	Use:   "graph <id>",
+	Short: "Render a molecule's DAG as DOT or Mermaid",
+	Long: `Render a molecule template or instantiated root as a graph.
+
+<id> may be either a molecule template ID (nodes are its steps, labeled by
+Ref and Title, edges follow Needs:, node style encodes Tier) or an
+instantiated root issue ID (nodes are the resolved child beads, colored by
+status: done=green, in_progress=yellow, ready=blue, blocked=gray,
+failed=red, pending-condition=orange, skipped=purple, edges annotated with
+any pending output bindings).
+
+Defaults to Graphviz DOT; use --format=mermaid for a Mermaid flowchart.
+Use --cluster-by=tier to group nodes into subgraph/subgraph-equivalent
+clusters by Tier.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMoleculeGraph,
+}
+
+func init() {
+	moleculeGraphCmd.Flags().StringVar(&moleculeGraphFormat, "format", "dot", "Output format: dot or mermaid")
+	moleculeGraphCmd.Flags().StringVar(&moleculeGraphOutput, "output", "", "Write to this file instead of stdout")
+	moleculeGraphCmd.Flags().StringVar(&moleculeGraphClusterBy, "cluster-by", "", "Group nodes into clusters by this field (only \"tier\" is supported)")
+	moleculeCmd.AddCommand(moleculeGraphCmd)
+}
+
+// graphNode is a format-agnostic node, built either from a template step or
+// a resolved child bead, so renderDOT/renderMermaid don't need to know which.
+type graphNode struct {
+	ID    string
+	Label string
+	Tier  string // "" if not applicable
+	Color string // "" if not applicable (template mode has no status)
+}
+
+type graphEdge struct {
+	From  string
+	To    string
+	Label string // resolved/pending output binding, if any
+}
+
+func runMoleculeGraph(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	if moleculeGraphFormat != "dot" && moleculeGraphFormat != "mermaid" {
+		return fmt.Errorf("unknown --format %q (want dot or mermaid)", moleculeGraphFormat)
+	}
+	if moleculeGraphClusterBy != "" && moleculeGraphClusterBy != "tier" {
+		return fmt.Errorf("unknown --cluster-by %q (only \"tier\" is supported)", moleculeGraphClusterBy)
+	}
+
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+
+	b := beads.New(workDir)
+
+	// An instantiated root has children; a template doesn't. Check instance
+	// first since it's the more specific, concrete case.
+	children, err := b.List(beads.ListOptions{Parent: id, Status: "all", Priority: -1})
+	var nodes []graphNode
+	var edges []graphEdge
+	if err == nil && len(children) > 0 {
+		nodes, edges = instanceGraph(children)
+	} else {
+		steps, stepErr := loadNestedMolecule(workDir, id)
+		if stepErr != nil {
+			return fmt.Errorf("%s is neither an instantiated root (no children) nor a parseable molecule template: %w", id, stepErr)
+		}
+		nodes, edges = templateGraph(steps)
+	}
+
+	var out string
+	if moleculeGraphFormat == "mermaid" {
+		out = renderMermaid(nodes, edges, moleculeGraphClusterBy)
+	} else {
+		out = renderDOT(nodes, edges, moleculeGraphClusterBy)
+	}
+
+	if moleculeGraphOutput != "" {
+		if err := os.WriteFile(moleculeGraphOutput, []byte(out), 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", moleculeGraphOutput, err)
+		}
+		fmt.Printf("%s Wrote graph to %s\n", style.Bold.Render("âœ“"), moleculeGraphOutput)
+		return nil
+	}
+
+	fmt.Print(out)
+	return nil
+}
+
+// templateGraph builds nodes/edges from a molecule template's parsed steps.
+func templateGraph(steps []beads.MoleculeStep) ([]graphNode, []graphEdge) {
+	var nodes []graphNode
+	var edges []graphEdge
+	meta := parseStepMeta(steps)
+
+	for _, s := range steps {
+		nodes = append(nodes, graphNode{
+			ID:    s.Ref,
+			Label: fmt.Sprintf("%s\\n%s", s.Ref, s.Title),
+			Tier:  s.Tier,
+		})
+		for _, need := range s.Needs {
+			label := ""
+			for varName, expr := range meta[s.Ref].With {
+				if strings.HasPrefix(expr, "steps."+need+".outputs.") {
+					label = fmt.Sprintf("%s=%s", varName, expr)
+					break
+				}
+			}
+			edges = append(edges, graphEdge{From: need, To: s.Ref, Label: label})
+		}
+	}
+
+	return nodes, edges
+}
+
+// instanceGraph builds nodes/edges from an instantiated root's resolved
+// child beads, colored by status and annotated with any pending output
+// bindings recorded on the child's description.
+func instanceGraph(children []*beads.Issue) ([]graphNode, []graphEdge) {
+	closedIDs := make(map[string]bool, len(children))
+	for _, c := range children {
+		if c.Status == "closed" {
+			closedIDs[c.ID] = true
+		}
+	}
+
+	var nodes []graphNode
+	var edges []graphEdge
+
+	for _, c := range children {
+		nodes = append(nodes, graphNode{
+			ID:    c.ID,
+			Label: fmt.Sprintf("%s\\n%s", c.ID, c.Title),
+			Color: statusColor(c, closedIDs),
+		})
+
+		bindings := extractPendingBindings(c.Description)
+		for _, depID := range c.DependsOn {
+			edges = append(edges, graphEdge{From: depID, To: c.ID, Label: bindings[depID]})
+		}
+	}
+
+	return nodes, edges
+}
+
+// statusColor maps a child bead's status to the color scheme Witnesses use
+// to eyeball an instance's shape: done=green, in_progress=yellow,
+// ready=blue, blocked=gray, failed=red, pending-condition=orange (waiting
+// on a When: to become decidable), skipped=purple (a When: resolved false,
+// or the step was skipped by an upstream OnFailure: skip-dependents).
+func statusColor(c *beads.Issue, closedIDs map[string]bool) string {
+	switch c.Status {
+	case "closed":
+		return "green"
+	case "in_progress":
+		return "yellow"
+	case "failed":
+		return "red"
+	case "blocked":
+		return "gray"
+	case "pending-condition":
+		return "orange"
+	case "skipped":
+		return "purple"
+	case "open":
+		for _, depID := range c.DependsOn {
+			if !closedIDs[depID] {
+				return "gray" // blocked on an unclosed dependency
+			}
+		}
+		return "blue" // ready
+	default:
+		return "gray"
+	}
+}
+
+// extractPendingBindings scrapes "pending_binding: <upstream-id>=<label>"
+// lines from a child bead's description - the convention InstantiateMolecule
+// is expected to write when it defers a steps.X.outputs.Y binding, mirroring
+// how extractMoleculeID scrapes "instantiated_from:" - into a map keyed by
+// the upstream bead ID the binding depends on, for edge annotation.
+func extractPendingBindings(description string) map[string]string {
+	bindings := make(map[string]string)
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "pending_binding:") {
+			continue
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(line, "pending_binding:"))
+		upstreamID, label, ok := strings.Cut(rest, "=")
+		if !ok {
+			continue
+		}
+		bindings[strings.TrimSpace(upstreamID)] = strings.TrimSpace(label)
+	}
+	return bindings
+}
+
+// renderDOT emits a Graphviz DOT rendering of nodes/edges.
+func renderDOT(nodes []graphNode, edges []graphEdge, clusterBy string) string {
+	var b strings.Builder
+	b.WriteString("digraph molecule {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=filled, fontname=\"monospace\"];\n\n")
+
+	if clusterBy == "tier" {
+		for _, tier := range sortedTiers(nodes) {
+			fmt.Fprintf(&b, "  subgraph \"cluster_%s\" {\n", dotID(tier))
+			fmt.Fprintf(&b, "    label=%q;\n", tierLabel(tier))
+			for _, n := range nodes {
+				if n.Tier != tier {
+					continue
+				}
+				fmt.Fprintf(&b, "    %s;\n", dotNode(n))
+			}
+			b.WriteString("  }\n")
+		}
+	} else {
+		for _, n := range nodes {
+			fmt.Fprintf(&b, "  %s;\n", dotNode(n))
+		}
+	}
+
+	b.WriteString("\n")
+	for _, e := range edges {
+		if e.Label != "" {
+			fmt.Fprintf(&b, "  %s -> %s [label=%q];\n", dotID(e.From), dotID(e.To), e.Label)
+		} else {
+			fmt.Fprintf(&b, "  %s -> %s;\n", dotID(e.From), dotID(e.To))
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotNode renders a single DOT node declaration with its label and, if set,
+// fill color.
+func dotNode(n graphNode) string {
+	attrs := fmt.Sprintf("label=%q", n.Label)
+	if n.Color != "" {
+		attrs += fmt.Sprintf(", fillcolor=%q", n.Color)
+	} else {
+		attrs += ", fillcolor=\"lightgray\""
+	}
+	return fmt.Sprintf("%s [%s]", dotID(n.ID), attrs)
+}
+
+// dotID sanitizes an arbitrary ref/ID into a bare DOT identifier by quoting
+// it, since step refs and bead IDs can contain characters DOT's unquoted
+// identifier syntax doesn't allow.
+func dotID(s string) string {
+	return fmt.Sprintf("%q", s)
+}
+
+// renderMermaid emits a Mermaid flowchart rendering of nodes/edges.
+func renderMermaid(nodes []graphNode, edges []graphEdge, clusterBy string) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	if clusterBy == "tier" {
+		for _, tier := range sortedTiers(nodes) {
+			fmt.Fprintf(&b, "  subgraph %s[%s]\n", mermaidID("cluster_"+tier), tierLabel(tier))
+			for _, n := range nodes {
+				if n.Tier != tier {
+					continue
+				}
+				fmt.Fprintf(&b, "    %s[%q]\n", mermaidID(n.ID), n.Label)
+			}
+			b.WriteString("  end\n")
+		}
+	} else {
+		for _, n := range nodes {
+			fmt.Fprintf(&b, "  %s[%q]\n", mermaidID(n.ID), n.Label)
+		}
+	}
+
+	for _, e := range edges {
+		if e.Label != "" {
+			fmt.Fprintf(&b, "  %s -->|%s| %s\n", mermaidID(e.From), e.Label, mermaidID(e.To))
+		} else {
+			fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(e.From), mermaidID(e.To))
+		}
+	}
+
+	for _, n := range nodes {
+		if n.Color != "" {
+			fmt.Fprintf(&b, "  style %s fill:%s\n", mermaidID(n.ID), n.Color)
+		}
+	}
+
+	return b.String()
+}
+
+// mermaidID sanitizes an arbitrary ref/ID into a bare Mermaid node
+// identifier - Mermaid node IDs can't contain spaces or most punctuation,
+// unlike the refs/bead-IDs we're given.
+func mermaidID(s string) string {
+	replacer := strings.NewReplacer("/", "_", ".", "_", "-", "_", " ", "_")
+	return replacer.Replace(s)
+}
+
+// sortedTiers returns the distinct, non-empty tiers present in nodes, in
+// stable sorted order so --cluster-by=tier output doesn't vary between runs.
+func sortedTiers(nodes []graphNode) []string {
+	seen := make(map[string]bool)
+	var tiers []string
+	for _, n := range nodes {
+		if n.Tier == "" || seen[n.Tier] {
+			continue
+		}
+		seen[n.Tier] = true
+		tiers = append(tiers, n.Tier)
+	}
+	sort.Strings(tiers)
+	return tiers
+}
+
+// tierLabel returns the human-facing cluster label for a tier.
+func tierLabel(tier string) string {
+	return fmt.Sprintf("tier: %s", tier)
+}
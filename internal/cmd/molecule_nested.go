@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// maxNestedMoleculeDepth bounds Uses: recursion so a molecule can't
+// transitively use itself into a stack overflow - real cycles across the
+// Uses: graph are also rejected by name (see nestedMoleculeCycleCheck).
+const maxNestedMoleculeDepth = 8
+
+// renderMoleculeSteps prints steps for 'gt molecule show', recursing into
+// a step's Uses: molecule (indented one level deeper) so a nested DAG
+// renders as a tree rather than a single leaf line.
+func renderMoleculeSteps(steps []beads.MoleculeStep, workDir, indent string, depth int) {
+	meta := parseStepMeta(steps)
+	for _, step := range steps {
+		needsStr := ""
+		if len(step.Needs) == 0 {
+			needsStr = style.Dim.Render("(ready first)")
+		} else {
+			needsStr = fmt.Sprintf("Needs: %s", strings.Join(step.Needs, ", "))
+		}
+
+		tierStr := ""
+		if step.Tier != "" {
+			tierStr = fmt.Sprintf(" [%s]", step.Tier)
+		}
+
+		fmt.Printf("%s%-12s â†’ %s%s\n", indent, step.Ref, needsStr, tierStr)
+
+		uses := meta[step.Ref].Uses
+		if uses == "" {
+			continue
+		}
+		if depth >= maxNestedMoleculeDepth {
+			fmt.Printf("%s  %s\n", indent, style.Dim.Render(fmt.Sprintf("uses: %s (max nesting depth reached)", uses)))
+			continue
+		}
+
+		sub, err := loadNestedMolecule(workDir, uses)
+		if err != nil {
+			fmt.Printf("%s  %s\n", indent, style.Dim.Render(fmt.Sprintf("uses: %s (%v)", uses, err)))
+			continue
+		}
+		fmt.Printf("%s  %s %s\n", indent, style.Dim.Render("uses:"), style.Bold.Render(uses))
+		renderMoleculeSteps(sub, workDir, indent+"    ", depth+1)
+	}
+}
+
+// loadNestedMolecule resolves a Uses: target the same way 'gt molecule
+// show'/'instantiate' resolve their top-level molecule ID: catalog first,
+// falling back to the database.
+func loadNestedMolecule(workDir, molID string) ([]beads.MoleculeStep, error) {
+	catalog, err := loadMoleculeCatalog(workDir)
+	if err == nil {
+		if catalogMol := catalog.Get(molID); catalogMol != nil {
+			return beads.ParseMoleculeSteps(catalogMol.ToIssue().Description)
+		}
+	}
+
+	b := beads.New(workDir)
+	mol, err := b.Show(molID)
+	if err != nil {
+		return nil, fmt.Errorf("getting molecule: %w", err)
+	}
+	return beads.ParseMoleculeSteps(mol.Description)
+}
+
+// nestedMoleculeCycleCheck walks the Uses: graph starting at molID and
+// reports an error if molID is reachable from itself - a molecule can't
+// transitively use itself. Separate from validateMoleculeDAG since this
+// graph is over molecule IDs, not step refs within one molecule.
+func nestedMoleculeCycleCheck(workDir, molID string) error {
+	visited := make(map[string]bool)
+	var walk func(id string, depth int) error
+	walk = func(id string, depth int) error {
+		if depth > maxNestedMoleculeDepth {
+			return fmt.Errorf("Uses: nesting exceeds max depth %d", maxNestedMoleculeDepth)
+		}
+		if visited[id] {
+			return fmt.Errorf("molecule %s transitively uses itself", id)
+		}
+		visited[id] = true
+		defer delete(visited, id)
+
+		steps, err := loadNestedMolecule(workDir, id)
+		if err != nil {
+			return err
+		}
+		meta := parseStepMeta(steps)
+		for _, s := range steps {
+			uses := meta[s.Ref].Uses
+			if uses == "" {
+				continue
+			}
+			if err := walk(uses, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(molID, 0)
+}
+
+// nestedSubProgress reports whether child is itself a nested-molecule
+// container (instantiated from a molecule with its own children) rather
+// than a leaf step, and if so its own progress. getMoleculeProgressInfo
+// calls this per child so a container's sub-DAG is folded into the
+// parent's totals directly during categorization, instead of counting the
+// container as a single step and patching the totals afterward.
+func nestedSubProgress(b *beads.Beads, workDir string, child *beads.Issue) (*MoleculeProgressInfo, bool) {
+	if extractMoleculeID(child.Description) == "" {
+		return nil, false // not a nested-molecule container
+	}
+
+	sub, err := getMoleculeProgressInfo(b, workDir, child.ID)
+	if err != nil || sub == nil {
+		return nil, false // leaf step, not a container - already counted normally
+	}
+
+	return sub, true
+}
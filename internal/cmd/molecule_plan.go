@@ -0,0 +1,358 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Molecule plan command flags.
+var moleculePlanMaxParallel int
+
+var moleculePlanCmd = &cobra.Command{
+	Use:   "plan <mol-id>",
+	Short: "Plan a molecule's execution as ordered waves",
+	Long: `Turn a molecule's Needs: graph into an ordered list of waves: each wave
+is the set of step refs whose dependencies are satisfied by all earlier
+waves, computed via Kahn's algorithm.
+
+Use --max-parallel=N to split any wave larger than N into sub-waves, e.g.
+for a Witness dispatching to a fixed-size polecat pool.
+
+Also reports the critical path - the longest tier-weighted root-to-leaf
+chain - using per-tier cost weights from ~/.gastown/tier-costs.yaml
+(defaults to cost 1 for every tier if absent).`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMoleculePlan,
+}
+
+func init() {
+	moleculePlanCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Output as JSON")
+	moleculePlanCmd.Flags().IntVar(&moleculePlanMaxParallel, "max-parallel", 0, "Split waves larger than N into sub-waves (0 = unlimited)")
+	moleculeCmd.AddCommand(moleculePlanCmd)
+}
+
+// Wave is the set of step refs ready to dispatch together: every ref in an
+// earlier wave that any of these refs Needs: has already been satisfied.
+type Wave struct {
+	Index             int      `json:"index"`
+	Refs              []string `json:"refs"`
+	EstimatedTierCost int      `json:"estimated_tier_cost"`
+}
+
+func runMoleculePlan(cmd *cobra.Command, args []string) error {
+	molID := args[0]
+
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+
+	steps, err := loadNestedMolecule(workDir, molID)
+	if err != nil {
+		return fmt.Errorf("loading molecule: %w", err)
+	}
+
+	if dag := validateMoleculeDAG(steps); !dag.Valid() {
+		printDAGValidation(dag)
+		return fmt.Errorf("molecule %s fails DAG validation (see above)", molID)
+	}
+
+	costs, err := loadTierCosts()
+	if err != nil {
+		return fmt.Errorf("loading tier costs: %w", err)
+	}
+
+	waves, totalDepth, err := planMoleculeExecution(steps, moleculePlanMaxParallel, costs)
+	if err != nil {
+		return err
+	}
+	path := criticalPath(steps, costs)
+
+	if moleculeJSON {
+		out := struct {
+			Waves        []Wave   `json:"waves"`
+			CriticalPath []string `json:"critical_path"`
+			TotalDepth   int      `json:"total_depth"`
+		}{waves, path, totalDepth}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+
+	fmt.Printf("\n%s %s %s\n\n", style.Bold.Render("Execution plan:"), molID,
+		style.Dim.Render(fmt.Sprintf("(%d wave(s), depth %d)", len(waves), totalDepth)))
+	for _, w := range waves {
+		fmt.Printf("  Wave %d: %s %s\n", w.Index+1, strings.Join(w.Refs, ", "),
+			style.Dim.Render(fmt.Sprintf("(cost %d)", w.EstimatedTierCost)))
+	}
+	if len(path) > 0 {
+		fmt.Printf("\nCritical path: %s\n", strings.Join(path, " -> "))
+	}
+
+	return nil
+}
+
+// planMoleculeExecution turns steps into ordered waves via Kahn's algorithm:
+// each round collects every step whose Needs: are all in an earlier round.
+// If maxParallel > 0, a round larger than maxParallel is split into several
+// same-priority sub-waves rather than raising the dispatchable concurrency
+// past what the caller can actually run at once. Returns the waves plus the
+// DAG's depth in rounds (before any maxParallel split), since that - not
+// the post-split wave count - is what "wave N of M" progress should track.
+func planMoleculeExecution(steps []beads.MoleculeStep, maxParallel int, costs map[string]int) ([]Wave, int, error) {
+	byRef := make(map[string]beads.MoleculeStep, len(steps))
+	inDegree := make(map[string]int, len(steps))
+	dependents := make(map[string][]string) // need -> refs that need it
+	for _, s := range steps {
+		byRef[s.Ref] = s
+		inDegree[s.Ref] = len(s.Needs)
+		for _, need := range s.Needs {
+			dependents[need] = append(dependents[need], s.Ref)
+		}
+	}
+
+	remaining := len(steps)
+	done := make(map[string]bool, len(steps))
+	var waves []Wave
+	rounds := 0
+
+	for remaining > 0 {
+		var round []string
+		for ref, deg := range inDegree {
+			if deg == 0 && !done[ref] {
+				round = append(round, ref)
+			}
+		}
+		if len(round) == 0 {
+			return nil, 0, fmt.Errorf("molecule has a cycle or dangling Needs: (%d step(s) never became ready)", remaining)
+		}
+		sort.Strings(round)
+		rounds++
+
+		for _, ref := range round {
+			done[ref] = true
+			remaining--
+		}
+		for _, ref := range round {
+			for _, dep := range dependents[ref] {
+				inDegree[dep]--
+			}
+		}
+
+		for _, chunk := range chunkRefs(round, maxParallel) {
+			waves = append(waves, Wave{
+				Refs:              chunk,
+				EstimatedTierCost: maxTierCost(chunk, byRef, costs),
+			})
+		}
+	}
+
+	for i := range waves {
+		waves[i].Index = i
+	}
+
+	return waves, rounds, nil
+}
+
+// chunkRefs splits refs into sub-slices of at most maxParallel, preserving
+// order. maxParallel <= 0 means unlimited (a single chunk).
+func chunkRefs(refs []string, maxParallel int) [][]string {
+	if maxParallel <= 0 || len(refs) <= maxParallel {
+		return [][]string{refs}
+	}
+
+	var chunks [][]string
+	for i := 0; i < len(refs); i += maxParallel {
+		end := i + maxParallel
+		if end > len(refs) {
+			end = len(refs)
+		}
+		chunks = append(chunks, refs[i:end])
+	}
+	return chunks
+}
+
+// criticalPath finds the longest tier-weighted root-to-leaf chain via DP
+// over a topological order: dist[ref] is the most expensive chain ending at
+// ref, and the overall critical path ends at whichever ref has the largest
+// dist.
+func criticalPath(steps []beads.MoleculeStep, costs map[string]int) []string {
+	byRef := make(map[string]beads.MoleculeStep, len(steps))
+	for _, s := range steps {
+		byRef[s.Ref] = s
+	}
+
+	order := topoOrder(steps, byRef)
+
+	dist := make(map[string]int, len(steps))
+	prev := make(map[string]string, len(steps))
+	best := ""
+	for _, ref := range order {
+		cost := tierCost(byRef[ref].Tier, costs)
+
+		maxPrev := 0
+		bestPred := ""
+		for _, need := range byRef[ref].Needs {
+			if dist[need] > maxPrev {
+				maxPrev = dist[need]
+				bestPred = need
+			}
+		}
+
+		dist[ref] = maxPrev + cost
+		if bestPred != "" {
+			prev[ref] = bestPred
+		}
+		if best == "" || dist[ref] > dist[best] {
+			best = ref
+		}
+	}
+
+	if best == "" {
+		return nil
+	}
+
+	var path []string
+	for ref := best; ref != ""; ref = prev[ref] {
+		path = append([]string{ref}, path...)
+	}
+	return path
+}
+
+// topoOrder returns steps in a Needs-respecting topological order via
+// post-order DFS: a step is appended only after every step it Needs: has
+// already been appended. Assumes steps are acyclic - callers validate the
+// DAG (validateMoleculeDAG) before relying on this order.
+func topoOrder(steps []beads.MoleculeStep, byRef map[string]beads.MoleculeStep) []string {
+	visited := make(map[string]bool, len(steps))
+	var order []string
+
+	var visit func(ref string)
+	visit = func(ref string) {
+		if visited[ref] {
+			return
+		}
+		visited[ref] = true
+		for _, need := range byRef[ref].Needs {
+			visit(need)
+		}
+		order = append(order, ref)
+	}
+
+	for _, s := range steps {
+		visit(s.Ref)
+	}
+	return order
+}
+
+// maxTierCost returns the highest per-tier cost among refs, since a wave's
+// wall-clock cost is bounded by its slowest concurrent step.
+func maxTierCost(refs []string, byRef map[string]beads.MoleculeStep, costs map[string]int) int {
+	max := 0
+	for _, ref := range refs {
+		if c := tierCost(byRef[ref].Tier, costs); c > max {
+			max = c
+		}
+	}
+	return max
+}
+
+// tierCost looks up tier's configured cost, defaulting to 1 for an unknown
+// or unconfigured tier (including the empty tier).
+func tierCost(tier string, costs map[string]int) int {
+	if tier == "" {
+		return 1
+	}
+	if c, ok := costs[tier]; ok {
+		return c
+	}
+	return 1
+}
+
+// loadTierCosts reads ~/.gastown/tier-costs.yaml, returning a nil map (every
+// tier costs 1) if the file doesn't exist.
+func loadTierCosts() (map[string]int, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("finding home directory: %w", err)
+	}
+
+	path := filepath.Join(home, ".gastown", "tier-costs.yaml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return parseTierCostsYAML(string(data))
+}
+
+// parseTierCostsYAML parses the one shape tier-costs.yaml needs - a
+// top-level "tiers:" map of tier name to integer cost:
+//
+//	tiers:
+//	  fast: 1
+//	  slow: 5
+//
+// Hand-rolled rather than pulling in a YAML library for one small config
+// file with no nesting, lists, or quoting to support.
+func parseTierCostsYAML(data string) (map[string]int, error) {
+	costs := make(map[string]int)
+	inTiers := false
+
+	for _, line := range strings.Split(data, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		indented := strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+		if !indented {
+			inTiers = trimmed == "tiers:"
+			continue
+		}
+		if !inTiers {
+			continue
+		}
+
+		key, val, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		val = strings.TrimSpace(val)
+
+		cost, err := strconv.Atoi(val)
+		if err != nil {
+			return nil, fmt.Errorf("tier %q: invalid cost %q: %w", key, val, err)
+		}
+		costs[key] = cost
+	}
+
+	return costs, nil
+}
+
+// extractStepRef extracts the originating template step ref from an
+// instantiated child bead's description - the "step_ref:" convention
+// InstantiateMolecule is expected to stamp on each child it creates,
+// mirroring how extractMoleculeID scrapes "instantiated_from:".
+func extractStepRef(description string) string {
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "step_ref:") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "step_ref:"))
+		}
+	}
+	return ""
+}
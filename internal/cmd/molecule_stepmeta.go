@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// stepMeta holds per-step directives the nested-molecule and DAG-validation
+// features need - Uses:, With:, Outputs:, When:, and OnFailure: - that
+// beads.MoleculeStep doesn't define (it only carries Ref, Title,
+// Instructions, Needs, WaitsFor, Tier, Type, and Backoff). Rather than
+// adding fields to that struct, these are authored as directive lines in a
+// step's Instructions text and scraped back out by parseStepMeta - the same
+// "extra concern lives in cmd, not in the struct" approach CatalogLockfile
+// and the digest manifest already use for data beads doesn't model.
+type stepMeta struct {
+	Uses      string
+	With      map[string]string
+	Outputs   []string
+	When      string
+	OnFailure string
+}
+
+// parseStepMeta builds a stepMeta lookup, keyed by Ref, for every step in
+// steps - one call up front lets the DAG/nested-molecule code read
+// meta[ref].Uses etc. instead of re-scanning Instructions at every use.
+func parseStepMeta(steps []beads.MoleculeStep) map[string]stepMeta {
+	out := make(map[string]stepMeta, len(steps))
+	for _, s := range steps {
+		out[s.Ref] = stepMeta{
+			Uses:      stepDirective(s.Instructions, "Uses"),
+			With:      stepDirectiveMap(s.Instructions, "With"),
+			Outputs:   stepDirectiveList(s.Instructions, "Outputs"),
+			When:      stepDirective(s.Instructions, "When"),
+			OnFailure: stepDirective(s.Instructions, "OnFailure"),
+		}
+	}
+	return out
+}
+
+// stepDirective returns the value of a "Key: value" line in instructions,
+// or "" if no such line is present.
+func stepDirective(instructions, key string) string {
+	prefix := key + ":"
+	for _, line := range strings.Split(instructions, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(line, prefix))
+		}
+	}
+	return ""
+}
+
+// stepDirectiveList parses a "Key: a, b, c" directive into its
+// comma-separated values, for Outputs:.
+func stepDirectiveList(instructions, key string) []string {
+	v := stepDirective(instructions, key)
+	if v == "" {
+		return nil
+	}
+	var out []string
+	for _, part := range strings.Split(v, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// stepDirectiveMap parses a "Key: a=1, b=2" directive into a map, for
+// With:.
+func stepDirectiveMap(instructions, key string) map[string]string {
+	v := stepDirective(instructions, key)
+	if v == "" {
+		return nil
+	}
+	out := make(map[string]string)
+	for _, part := range strings.Split(v, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) == 2 {
+			out[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+	return out
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Molecule watch command flags.
+var moleculeWatchInterval time.Duration
+
+var moleculeWatchCmd = &cobra.Command{
+	Use:   "watch [target]",
+	Short: "Continuously show an agent's molecule progress",
+	Long: `Refresh 'gt mol status' on a timer instead of printing it once: a
+live progress bar, percent, done/total, a rolling steps-per-minute rate, and
+an ETA to completion.
+
+If no target is specified, watches the current agent, same as 'gt mol
+status'.
+
+Use --json to emit one JSON status object per tick to stdout instead of
+redrawing a bar, so a hook can pipe the stream elsewhere. Press Ctrl-C to
+stop early; watch also exits on its own, with a success banner, once the
+molecule completes.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMoleculeWatch,
+}
+
+func init() {
+	moleculeWatchCmd.Flags().DurationVar(&moleculeWatchInterval, "interval", 2*time.Second, "Refresh interval")
+	moleculeWatchCmd.Flags().BoolVar(&moleculeJSON, "json", false, "Emit one JSON status object per tick instead of a live bar")
+	moleculeCmd.AddCommand(moleculeWatchCmd)
+}
+
+// progressSample is one (timestamp, DoneSteps) point in the rolling rate
+// ring buffer - just enough history to estimate a steps-per-minute rate
+// without needing every tick since the watch started.
+type progressSample struct {
+	at   time.Time
+	done int
+}
+
+// moleculeWatchRateWindow bounds the ring buffer: only the most recent
+// samples contribute to the rate, so a rate computed late in a long watch
+// reflects current pace rather than being dragged down by a slow start.
+const moleculeWatchRateWindow = 5
+
+func runMoleculeWatch(cmd *cobra.Command, args []string) error {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+
+	var samples []progressSample
+	linesPrinted := 0
+
+	for {
+		status, err := gatherMoleculeStatus(args)
+		if err != nil {
+			return err
+		}
+
+		if status.Progress != nil {
+			samples = append(samples, progressSample{at: time.Now(), done: status.Progress.DoneSteps})
+			if len(samples) > moleculeWatchRateWindow {
+				samples = samples[len(samples)-moleculeWatchRateWindow:]
+			}
+		}
+
+		if moleculeJSON {
+			enc := json.NewEncoder(os.Stdout)
+			if err := enc.Encode(status); err != nil {
+				return err
+			}
+		} else {
+			linesPrinted = renderWatchFrame(status, samples, linesPrinted)
+		}
+
+		if status.Progress != nil && status.Progress.Complete {
+			if !moleculeJSON {
+				fmt.Printf("\n%s\n", style.Bold.Render("✓ Molecule complete!"))
+			}
+			return nil
+		}
+
+		select {
+		case <-sigCh:
+			if !moleculeJSON {
+				fmt.Println()
+			}
+			return nil
+		case <-time.After(moleculeWatchInterval):
+		}
+	}
+}
+
+// renderWatchFrame redraws the live bar in place: it moves the cursor up by
+// however many lines the previous frame printed and clears each one before
+// printing the new frame, rather than assuming a fixed line count - a
+// terminal resize changing line-wrap counts can't tear the display into
+// leftover fragments this way. Returns the number of lines this frame
+// printed, for the next call.
+func renderWatchFrame(status MoleculeStatusInfo, samples []progressSample, prevLines int) int {
+	for i := 0; i < prevLines; i++ {
+		fmt.Print("\x1b[1A\x1b[2K")
+	}
+
+	var lines []string
+	lines = append(lines, fmt.Sprintf("%s %s", style.Bold.Render("Watching:"), status.Target))
+
+	if status.Progress == nil {
+		lines = append(lines, style.Dim.Render("No molecule attached"))
+	} else {
+		p := status.Progress
+		barWidth := 30
+		filled := (p.Percent * barWidth) / 100
+		bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
+		lines = append(lines, fmt.Sprintf("[%s] %d%% (%d/%d)", bar, p.Percent, p.DoneSteps, p.TotalSteps))
+
+		rate := watchRate(samples)
+		eta := "?"
+		if rate > 0 {
+			remaining := p.TotalSteps - p.DoneSteps
+			eta = time.Duration(float64(remaining) / rate * float64(time.Minute)).Round(time.Second).String()
+		}
+		lines = append(lines, fmt.Sprintf("Rate: %.1f steps/min   ETA: %s", rate, eta))
+
+		if len(p.NextWave) > 0 {
+			lines = append(lines, fmt.Sprintf("Ready:       %s", strings.Join(p.NextWave, ", ")))
+		}
+	}
+
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+	return len(lines)
+}
+
+// watchRate computes a steps-per-minute rate from the oldest and newest
+// samples in the ring buffer. Returns 0 if there isn't enough history yet,
+// or if DoneSteps hasn't advanced (a stalled molecule has no meaningful rate).
+func watchRate(samples []progressSample) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	doneDelta := last.done - first.done
+	minutes := last.at.Sub(first.at).Minutes()
+	if doneDelta <= 0 || minutes <= 0 {
+		return 0
+	}
+	return float64(doneDelta) / minutes
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// whenExprPattern matches the one shape of When: expression the evaluator
+// understands: a comparison of an upstream step's status or a named output
+// against a quoted literal, e.g.
+//
+//	steps.build.outputs.tests_passed == "true"
+//	steps.deploy.status != "failed"
+var whenExprPattern = regexp.MustCompile(`^steps\.([^.]+)\.(status|outputs\.[^.\s]+)\s*(==|!=)\s*"([^"]*)"$`)
+
+// parsedWhenExpr is a When: expression broken into its parts.
+type parsedWhenExpr struct {
+	Ref      string // upstream step ref
+	Field    string // "status" or "outputs.<key>"
+	Operator string // "==" or "!="
+	Value    string
+}
+
+// parseWhenExpr parses a step's When: value. Returns an error if expr
+// doesn't match the one comparison shape the evaluator supports.
+func parseWhenExpr(expr string) (*parsedWhenExpr, error) {
+	m := whenExprPattern.FindStringSubmatch(strings.TrimSpace(expr))
+	if m == nil {
+		return nil, fmt.Errorf("unrecognized When: expression (want steps.<ref>.status or steps.<ref>.outputs.<key>, ==/!=, and a quoted value)")
+	}
+	return &parsedWhenExpr{Ref: m[1], Field: m[2], Operator: m[3], Value: m[4]}, nil
+}
+
+// OnFailurePolicy is a step's parsed OnFailure: value.
+type OnFailurePolicy struct {
+	Kind       string // "fail", "continue", "retry", or "skip-dependents"
+	RetryLimit int    // only meaningful when Kind == "retry"
+}
+
+var onFailureRetryPattern = regexp.MustCompile(`^retry:(\d+)$`)
+
+// parseOnFailurePolicy parses a step's OnFailure: value, defaulting to
+// "fail" - the same terminal-on-first-failure behavior as an unset
+// OnFailure: - when onFailure is empty.
+func parseOnFailurePolicy(onFailure string) (OnFailurePolicy, error) {
+	switch onFailure {
+	case "", "fail":
+		return OnFailurePolicy{Kind: "fail"}, nil
+	case "continue":
+		return OnFailurePolicy{Kind: "continue"}, nil
+	case "skip-dependents":
+		return OnFailurePolicy{Kind: "skip-dependents"}, nil
+	}
+
+	if m := onFailureRetryPattern.FindStringSubmatch(onFailure); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil || n < 1 {
+			return OnFailurePolicy{}, fmt.Errorf("invalid retry count in %q (want retry:N with N >= 1)", onFailure)
+		}
+		return OnFailurePolicy{Kind: "retry", RetryLimit: n}, nil
+	}
+
+	return OnFailurePolicy{}, fmt.Errorf("unrecognized OnFailure: %q (want fail, continue, retry:N, or skip-dependents)", onFailure)
+}
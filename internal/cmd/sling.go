@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/connection"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/polecat"
@@ -31,6 +33,10 @@ var (
 	slingForce    bool   // Re-sling even if hook has work
 	slingNoStart  bool   // Assign work but don't start session
 	slingCreate   bool   // Create polecat if it doesn't exist
+
+	slingConnection       string // Named remote connection to dispatch through
+	slingStdinRequest     bool   // Internal: read a RemoteSlingRequest from stdin (far side of --connection)
+	slingPrintFingerprint bool   // Internal: print this town's fingerprint and exit
 )
 
 var slingCmd = &cobra.Command{
@@ -58,7 +64,7 @@ What Happens When You Sling:
   2. ASSIGN - Assign molecule/issue to target agent
   3. PIN - Put work on agent's hook (pinned bead)
   4. IGNITION - Agent wakes and runs the work`,
-	Args: cobra.ExactArgs(2),
+	Args: cobra.MaximumNArgs(2),
 	RunE: runSling,
 }
 
@@ -70,15 +76,21 @@ func init() {
 	slingCmd.Flags().BoolVar(&slingNoStart, "no-start", false, "Assign work but don't start session")
 	slingCmd.Flags().BoolVar(&slingCreate, "create", false, "Create polecat if it doesn't exist")
 
+	slingCmd.Flags().StringVar(&slingConnection, "connection", os.Getenv("GT_CONNECTION"), "Dispatch to a named remote connection (see 'gt system connection') instead of slinging locally")
+	slingCmd.Flags().BoolVar(&slingStdinRequest, "stdin-request", false, "Read a RemoteSlingRequest from stdin instead of <thing>/<target> args (used on the far side of --connection)")
+	slingCmd.Flags().BoolVar(&slingPrintFingerprint, "print-fingerprint", false, "Print this town's fingerprint and exit (used to populate a connection's --fingerprint)")
+	slingCmd.Flags().MarkHidden("stdin-request")
+	slingCmd.Flags().MarkHidden("print-fingerprint")
+
 	rootCmd.AddCommand(slingCmd)
 }
 
 // SlingThing represents what's being slung.
 type SlingThing struct {
-	Kind    string // "proto", "issue", or "epic"
-	ID      string // The identifier (proto name or issue ID)
-	Proto   string // If Kind=="issue" and --molecule set, the proto name
-	IsWisp  bool   // If --wisp flag set
+	Kind   string // "proto", "issue", or "epic"
+	ID     string // The identifier (proto name or issue ID)
+	Proto  string // If Kind=="issue" and --molecule set, the proto name
+	IsWisp bool   // If --wisp flag set
 }
 
 // SlingTarget represents who's being slung at.
@@ -88,10 +100,48 @@ type SlingTarget struct {
 	Name string // Agent name (for polecats)
 }
 
+// SlingOptions carries the --force/--no-start/--create flags explicitly
+// into the slingTo* dispatch functions. They don't read the slingForce/
+// slingNoStart/slingCreate flag globals directly: those globals are only
+// safe to read from the single-threaded CLI entry points (runSling,
+// runSlingStdinRequest) - apiSlingFunc serves concurrent HTTP requests and
+// must never write to package-level state to pass a request's flags down.
+type SlingOptions struct {
+	Force   bool
+	NoStart bool
+	Create  bool
+}
+
+// SlingOutcome carries the results of a sling back to callers that need
+// them structurally (e.g. the HTTP API), not just as printed progress.
+// It's populated on a best-effort basis as each slingTo* function learns
+// the relevant fields, so a caller that only wants issueID can stop
+// reading as soon as that's set.
+type SlingOutcome struct {
+	IssueID     string
+	RootIssueID string
+	SessionName string
+}
+
 func runSling(cmd *cobra.Command, args []string) error {
+	if slingPrintFingerprint {
+		return runSlingPrintFingerprint()
+	}
+
+	if slingStdinRequest {
+		return runSlingStdinRequest()
+	}
+
+	if len(args) != 2 {
+		return fmt.Errorf("accepts 2 arg(s), received %d", len(args))
+	}
 	thingArg := args[0]
 	targetArg := args[1]
 
+	if slingConnection != "" {
+		return runSlingRemote(thingArg, targetArg)
+	}
+
 	// Find workspace
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -122,16 +172,157 @@ func runSling(cmd *cobra.Command, args []string) error {
 		thing.Kind, style.Bold.Render(thing.ID),
 		style.Bold.Render(targetArg))
 
+	opts := SlingOptions{Force: slingForce, NoStart: slingNoStart, Create: slingCreate}
+
 	// Route based on target kind
+	var dispatchErr error
+	switch target.Kind {
+	case "polecat":
+		dispatchErr = slingToPolecat(townRoot, target, thing, opts, nil)
+	case "deacon":
+		dispatchErr = slingToDeacon(townRoot, target, thing, opts, nil)
+	case "witness":
+		dispatchErr = slingToWitness(townRoot, target, thing, opts, nil)
+	case "refinery":
+		dispatchErr = slingToRefinery(townRoot, target, thing, opts, nil)
+	default:
+		dispatchErr = fmt.Errorf("unknown target kind: %s", target.Kind)
+	}
+
+	if dispatchErr != nil {
+		if path, dumpErr := dumpSlingFailure(townRoot, target, thing, dispatchErr); dumpErr == nil {
+			fmt.Printf("%s Support bundle written to %s\n", style.Dim.Render("Diagnostics:"), path)
+		} else {
+			fmt.Printf("%s could not write support bundle: %v\n", style.Dim.Render("Warning:"), dumpErr)
+		}
+	}
+
+	return dispatchErr
+}
+
+// runSlingPrintFingerprint prints the current town's fingerprint, for an
+// operator to paste into `gt system connection add --fingerprint`.
+func runSlingPrintFingerprint() error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	fingerprint, err := connection.TownFingerprint(townRoot)
+	if err != nil {
+		return fmt.Errorf("computing fingerprint: %w", err)
+	}
+
+	fmt.Println(fingerprint)
+	return nil
+}
+
+// runSlingRemote dispatches thingArg/targetArg to the named connection
+// instead of slinging locally, streaming the remote gt sling's output back
+// as it arrives.
+func runSlingRemote(thingArg, targetArg string) error {
+	store, err := connection.Load()
+	if err != nil {
+		return fmt.Errorf("loading connections: %w", err)
+	}
+
+	c, err := store.Get(slingConnection)
+	if err != nil {
+		return fmt.Errorf("resolving connection: %w", err)
+	}
+
+	fmt.Printf("Slinging %s at %s via connection %s\n",
+		style.Bold.Render(thingArg), style.Bold.Render(targetArg), style.Bold.Render(c.Name))
+
+	req := connection.RemoteSlingRequest{
+		ThingArg:  thingArg,
+		TargetArg: targetArg,
+		Molecule:  slingMolecule,
+		Priority:  slingPriority,
+		Wisp:      slingWisp,
+		Force:     slingForce,
+		NoStart:   slingNoStart,
+		Create:    slingCreate,
+	}
+
+	if err := connection.RunRemoteSling(store, c, req, os.Stdout, os.Stderr); err != nil {
+		return fmt.Errorf("remote sling via %s: %w", c.Name, err)
+	}
+
+	// Notify the local witness too - the remote gt sling notifies its own
+	// witness, but nothing on this machine otherwise records that the
+	// dispatch happened.
+	if townRoot, err := workspace.FindFromCwdOrError(); err == nil {
+		notifyLocalWitnessOfRemoteSling(townRoot, c.Name, thingArg, targetArg)
+	}
+
+	return nil
+}
+
+// notifyLocalWitnessOfRemoteSling sends a best-effort mail notification to
+// the local rig's witness recording a remote dispatch. Failures are printed
+// but don't fail the sling, since the remote side has already run.
+func notifyLocalWitnessOfRemoteSling(townRoot, connName, thingArg, targetArg string) {
+	rigName, err := inferRigFromCwd(townRoot)
+	if err != nil {
+		return
+	}
+
+	router := mail.NewRouter(townRoot)
+	msg := &mail.Message{
+		To:      fmt.Sprintf("%s/witness", rigName),
+		From:    detectSender(),
+		Subject: fmt.Sprintf("SLING: dispatched to %s via connection %s", targetArg, connName),
+		Body:    fmt.Sprintf("Thing: %s\nTarget: %s\nConnection: %s\nSlung by: %s", thingArg, targetArg, connName, detectSender()),
+	}
+	if err := router.Send(msg); err != nil {
+		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("Warning: could not notify local witness: %v", err)))
+	}
+}
+
+// runSlingStdinRequest is the far side of --connection: it reads a
+// RemoteSlingRequest JSON payload from stdin and runs the normal local sling
+// path with it, rather than trusting anything the remote caller computed.
+func runSlingStdinRequest() error {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("reading request from stdin: %w", err)
+	}
+
+	var req connection.RemoteSlingRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return fmt.Errorf("parsing request: %w", err)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	target, err := parseSlingTarget(req.TargetArg, townRoot)
+	if err != nil {
+		return fmt.Errorf("invalid target: %w", err)
+	}
+
+	rigPath := filepath.Join(townRoot, target.Rig)
+	thing, err := parseSlingThing(req.ThingArg, rigPath)
+	if err != nil {
+		return fmt.Errorf("invalid thing: %w", err)
+	}
+	thing.Proto = req.Molecule
+	thing.IsWisp = req.Wisp
+
+	opts := SlingOptions{Force: req.Force, NoStart: req.NoStart, Create: req.Create}
+
 	switch target.Kind {
 	case "polecat":
-		return slingToPolecat(townRoot, target, thing)
+		return slingToPolecat(townRoot, target, thing, opts, nil)
 	case "deacon":
-		return slingToDeacon(townRoot, target, thing)
+		return slingToDeacon(townRoot, target, thing, opts, nil)
 	case "witness":
-		return slingToWitness(townRoot, target, thing)
+		return slingToWitness(townRoot, target, thing, opts, nil)
 	case "refinery":
-		return slingToRefinery(townRoot, target, thing)
+		return slingToRefinery(townRoot, target, thing, opts, nil)
 	default:
 		return fmt.Errorf("unknown target kind: %s", target.Kind)
 	}
@@ -284,7 +475,7 @@ func looksLikeIssueID(s string) bool {
 }
 
 // slingToPolecat handles slinging work to a polecat.
-func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) error {
+func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing, opts SlingOptions, outcome *SlingOutcome) error {
 	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
 	if err != nil {
@@ -314,7 +505,7 @@ func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) err
 
 	if polecatExists {
 		// Check for existing work on hook (unless --force)
-		if !slingForce {
+		if !opts.Force {
 			if err := checkHookCollision(polecatAddress, r.Path); err != nil {
 				return err
 			}
@@ -335,17 +526,21 @@ func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) err
 				fmt.Printf("  • %d unpushed commit(s)\n", workStatus.UnpushedCommits)
 			}
 			fmt.Println()
-			if !slingForce {
+			if !opts.Force {
 				return fmt.Errorf("polecat '%s' has uncommitted work\nUse --force to proceed anyway", polecatName)
 			}
 			fmt.Printf("%s Proceeding with --force\n", style.Dim.Render("Warning:"))
+
+			if err := snapshotInboxBeforeRecreate(router, polecatAddress, existingPolecat.ClonePath); err != nil {
+				fmt.Printf("%s could not snapshot inbox: %v\n", style.Dim.Render("Warning:"), err)
+			}
 		}
 
 		// Check for unread mail
 		mailbox, mailErr := router.GetMailbox(polecatAddress)
 		if mailErr == nil {
 			_, unread, _ := mailbox.Count()
-			if unread > 0 && !slingForce {
+			if unread > 0 && !opts.Force {
 				return fmt.Errorf("polecat '%s' has %d unread message(s)\nUse --force to override", polecatName, unread)
 			} else if unread > 0 {
 				fmt.Printf("%s Polecat has %d unread message(s), proceeding with --force\n",
@@ -355,12 +550,12 @@ func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) err
 
 		// Recreate polecat with fresh worktree
 		fmt.Printf("Recreating polecat %s with fresh worktree...\n", polecatName)
-		if _, err = polecatMgr.Recreate(polecatName, slingForce); err != nil {
+		if _, err = polecatMgr.Recreate(polecatName, opts.Force); err != nil {
 			return fmt.Errorf("recreating polecat: %w", err)
 		}
 		fmt.Printf("%s Fresh worktree created\n", style.Bold.Render("✓"))
 	} else if err == polecat.ErrPolecatNotFound {
-		if !slingCreate {
+		if !opts.Create {
 			return fmt.Errorf("polecat '%s' not found (use --create to create)", polecatName)
 		}
 		fmt.Printf("Creating polecat %s...\n", polecatName)
@@ -405,6 +600,13 @@ func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) err
 		return fmt.Errorf("epics should be slung at refinery/, not polecat/")
 	}
 
+	if outcome != nil {
+		outcome.IssueID = issueID
+		if moleculeCtx != nil {
+			outcome.RootIssueID = moleculeCtx.RootIssueID
+		}
+	}
+
 	// Assign issue to polecat
 	if err := polecatMgr.AssignIssue(polecatName, issueID); err != nil {
 		return fmt.Errorf("assigning issue: %w", err)
@@ -423,7 +625,7 @@ func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) err
 		fmt.Printf("%s beads push: %v\n", style.Dim.Render("Warning:"), err)
 	}
 
-	if slingNoStart {
+	if opts.NoStart {
 		fmt.Printf("\n  %s\n", style.Dim.Render("Use 'gt session start' to start the session"))
 		return nil
 	}
@@ -473,6 +675,9 @@ func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) err
 
 	// Nudge polecat
 	sessionName := sessMgr.SessionName(polecatName)
+	if outcome != nil {
+		outcome.SessionName = sessionName
+	}
 	nudgeMsg := fmt.Sprintf("You have a work assignment. Run 'gt mail inbox' to see it, then start working on issue %s.", issueID)
 	if err := t.NudgeSession(sessionName, nudgeMsg); err != nil {
 		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("Warning: could not nudge: %v", err)))
@@ -500,7 +705,7 @@ func slingToPolecat(townRoot string, target *SlingTarget, thing *SlingThing) err
 }
 
 // slingToDeacon handles slinging work to the deacon.
-func slingToDeacon(townRoot string, target *SlingTarget, thing *SlingThing) error {
+func slingToDeacon(townRoot string, target *SlingTarget, thing *SlingThing, opts SlingOptions, outcome *SlingOutcome) error {
 	if thing.Kind != "proto" {
 		return fmt.Errorf("deacon only accepts protos (like 'patrol'), not issues")
 	}
@@ -544,13 +749,13 @@ func slingToDeacon(townRoot string, target *SlingTarget, thing *SlingThing) erro
 }
 
 // slingToWitness handles slinging work to the witness.
-func slingToWitness(townRoot string, target *SlingTarget, thing *SlingThing) error {
+func slingToWitness(townRoot string, target *SlingTarget, thing *SlingThing, opts SlingOptions, outcome *SlingOutcome) error {
 	// Similar to deacon - update hook and optionally signal
 	return fmt.Errorf("slinging to witness not yet implemented")
 }
 
 // slingToRefinery handles slinging work to the refinery.
-func slingToRefinery(townRoot string, target *SlingTarget, thing *SlingThing) error {
+func slingToRefinery(townRoot string, target *SlingTarget, thing *SlingThing, opts SlingOptions, outcome *SlingOutcome) error {
 	if thing.Kind != "epic" {
 		return fmt.Errorf("refinery accepts epics for batch processing, not %s", thing.Kind)
 	}
@@ -595,12 +800,14 @@ func spawnMoleculeFromProto(beadsPath string, thing *SlingThing, assignee string
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
-	if err := cmd.Run(); err != nil {
+	runErr := cmd.Run()
+	recordMolOutput(stdout.String(), stderr.String())
+	if runErr != nil {
 		errMsg := strings.TrimSpace(stderr.String())
 		if errMsg != "" {
 			return "", nil, fmt.Errorf("running molecule: %s", errMsg)
 		}
-		return "", nil, fmt.Errorf("running molecule: %w", err)
+		return "", nil, fmt.Errorf("running molecule: %w", runErr)
 	}
 
 	// Parse result
@@ -646,12 +853,14 @@ func spawnMoleculeOnIssue(beadsPath string, thing *SlingThing, assignee string)
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
-	if err := cmd.Run(); err != nil {
+	runErr := cmd.Run()
+	recordMolOutput(stdout.String(), stderr.String())
+	if runErr != nil {
 		errMsg := strings.TrimSpace(stderr.String())
 		if errMsg != "" {
 			return "", nil, fmt.Errorf("running molecule: %s", errMsg)
 		}
-		return "", nil, fmt.Errorf("running molecule: %w", err)
+		return "", nil, fmt.Errorf("running molecule: %w", runErr)
 	}
 
 	var molResult struct {
@@ -679,6 +888,31 @@ func spawnMoleculeOnIssue(beadsPath string, thing *SlingThing, assignee string)
 	return molResult.RootID, moleculeCtx, nil
 }
 
+// snapshotInboxBeforeRecreate dumps address's inbox to
+// <clonePath>/.gastown-backups/inbox-<timestamp>.mbox before Recreate wipes
+// the polecat's worktree, so a --force sling over unread mail is still
+// recoverable with 'gt mail import'.
+func snapshotInboxBeforeRecreate(router *mail.Router, address, clonePath string) error {
+	backupDir := filepath.Join(clonePath, ".gastown-backups")
+	if err := os.MkdirAll(backupDir, 0755); err != nil {
+		return fmt.Errorf("creating backup dir: %w", err)
+	}
+
+	backupPath := filepath.Join(backupDir, fmt.Sprintf("inbox-%d.mbox", time.Now().Unix()))
+	f, err := os.Create(backupPath)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", backupPath, err)
+	}
+	defer f.Close()
+
+	if err := router.ExportMbox(address, f); err != nil {
+		return fmt.Errorf("exporting %s: %w", address, err)
+	}
+
+	fmt.Printf("%s Inbox snapshotted to %s\n", style.Dim.Render("Note:"), backupPath)
+	return nil
+}
+
 // checkHookCollision checks if the agent's hook already has work.
 func checkHookCollision(agentAddress, beadsPath string) error {
 	// Parse agent address to get the role for handoff bead lookup
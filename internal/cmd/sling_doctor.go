@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// lastMolOutput holds the tail of the most recent `bd mol run` stdout/stderr,
+// so a doctor bundle for a failed sling can include it without plumbing the
+// output through every call site that can fail afterward.
+var lastMolOutput string
+
+// recordMolOutput records a molecule-run's combined output for doctor
+// bundles. Called right after cmd.Run() returns, success or failure, so the
+// bundle for a later failure (e.g. router.Send or sessMgr.Start) still has
+// the run that produced the issue being worked.
+func recordMolOutput(stdout, stderr string) {
+	var combined strings.Builder
+	if stdout != "" {
+		combined.WriteString("--- stdout ---\n")
+		combined.WriteString(stdout)
+	}
+	if stderr != "" {
+		if combined.Len() > 0 {
+			combined.WriteString("\n")
+		}
+		combined.WriteString("--- stderr ---\n")
+		combined.WriteString(stderr)
+	}
+	lastMolOutput = combined.String()
+}
+
+// doctor command flags
+var (
+	slingDoctorUpload      string
+	slingDoctorStdout      bool
+	slingDoctorIncludeMail bool
+)
+
+var slingDoctorCmd = &cobra.Command{
+	Use:   "doctor [agent]",
+	Short: "Collect a diagnostic support bundle for a sling dispatch",
+	Long: `Assemble the same support bundle a failed 'gt sling' writes automatically,
+without needing a failure: git/tmux/mailbox/handoff context for <agent> (or
+the whole town if omitted), redacted and archived.
+
+<agent> uses the same format as 'gt sling's target: polecat/name, deacon/,
+witness/, or rig/polecat/name.
+
+By default the bundle is written to mayor/support/sling-<timestamp>.tar.gz.
+Use --stdout to stream the tar.gz to stdout instead, or --upload <url> to
+POST it somewhere rather than writing it to disk.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSlingDoctor,
+}
+
+func init() {
+	slingDoctorCmd.Flags().StringVar(&slingDoctorUpload, "upload", "", "POST the bundle to this URL instead of writing it to disk")
+	slingDoctorCmd.Flags().BoolVar(&slingDoctorStdout, "stdout", false, "Stream the bundle to stdout instead of writing it to disk")
+	slingDoctorCmd.Flags().BoolVar(&slingDoctorIncludeMail, "include-mail", false, "Include message bodies in the inbox summary (counts/subjects only by default)")
+
+	slingCmd.AddCommand(slingDoctorCmd)
+}
+
+func runSlingDoctor(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	var target *SlingTarget
+	if len(args) == 1 {
+		target, err = parseSlingTarget(args[0], townRoot)
+		if err != nil {
+			return fmt.Errorf("invalid agent: %w", err)
+		}
+	}
+
+	in := buildSlingDoctorInput(townRoot, target, nil, nil, slingDoctorIncludeMail)
+	opts := doctor.Options{IncludeMail: slingDoctorIncludeMail}
+
+	switch {
+	case slingDoctorStdout:
+		b, err := doctor.Build(in, opts)
+		if err != nil {
+			return fmt.Errorf("building bundle: %w", err)
+		}
+		return b.WriteTarGz(os.Stdout)
+
+	case slingDoctorUpload != "":
+		b, err := doctor.Build(in, opts)
+		if err != nil {
+			return fmt.Errorf("building bundle: %w", err)
+		}
+		if err := doctor.Upload(slingDoctorUpload, b); err != nil {
+			return fmt.Errorf("uploading bundle: %w", err)
+		}
+		fmt.Printf("%s Uploaded support bundle to %s\n", style.Bold.Render("✓"), slingDoctorUpload)
+		return nil
+
+	default:
+		path, err := writeSlingDoctorBundle(townRoot, in, opts)
+		if err != nil {
+			return fmt.Errorf("writing bundle: %w", err)
+		}
+		fmt.Printf("%s Wrote support bundle to %s\n", style.Bold.Render("✓"), path)
+		return nil
+	}
+}
+
+// dumpSlingFailure writes a diagnostic support bundle for a dispatch that
+// failed after target parsing, so an opaque error like "polecat 'alpha' has
+// 3 unread messages" becomes an artifact a user can attach when filing a bug.
+func dumpSlingFailure(townRoot string, target *SlingTarget, thing *SlingThing, dispatchErr error) (string, error) {
+	in := buildSlingDoctorInput(townRoot, target, thing, dispatchErr, false)
+	return writeSlingDoctorBundle(townRoot, in, doctor.Options{})
+}
+
+// writeSlingDoctorBundle builds and writes in as a tar.gz under
+// mayor/support/sling-<timestamp>.tar.gz, returning the path written.
+func writeSlingDoctorBundle(townRoot string, in doctor.Input, opts doctor.Options) (string, error) {
+	b, err := doctor.Build(in, opts)
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(townRoot, "mayor", "support")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating support dir: %w", err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("sling-%d.tar.gz", time.Now().Unix()))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("creating %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if err := b.WriteTarGz(f); err != nil {
+		return "", fmt.Errorf("writing bundle: %w", err)
+	}
+	return path, nil
+}
+
+// buildSlingDoctorInput gathers everything doctor.Build needs, on a
+// best-effort basis: a field that can't be collected (agent doesn't exist
+// yet, clone missing, tmux not installed) is left blank rather than failing
+// the whole bundle.
+func buildSlingDoctorInput(townRoot string, target *SlingTarget, thing *SlingThing, dispatchErr error, includeMail bool) doctor.Input {
+	in := doctor.Input{Err: dispatchErr}
+	if thing != nil {
+		in.Thing = thing
+	}
+	if target != nil {
+		in.Target = target
+	}
+
+	in.MolOutput = doctor.TailLines(lastMolOutput, 200)
+	in.Versions = gatherVersions()
+	in.TmuxLs = runCapture("", "tmux", "ls")
+
+	if target == nil {
+		return in
+	}
+
+	rigPath := filepath.Join(townRoot, target.Rig)
+	agentAddress := target.Rig
+	if target.Name != "" {
+		agentAddress = fmt.Sprintf("%s/%s", target.Rig, target.Name)
+	} else if target.Kind != "" {
+		agentAddress = fmt.Sprintf("%s/%s", target.Rig, target.Kind)
+	}
+
+	in.Handoff = gatherHandoffText(rigPath, agentAddress)
+	in.InboxSummary = gatherInboxSummary(rigPath, agentAddress, includeMail)
+
+	if target.Kind == "polecat" && target.Name != "" {
+		if clonePath := polecatClonePath(townRoot, target); clonePath != "" {
+			in.GitStatus = runCapture(clonePath, "git", "status")
+			in.GitLog = runCapture(clonePath, "git", "log", "-5")
+			in.StashList = runCapture(clonePath, "git", "stash", "list")
+		}
+	}
+
+	return in
+}
+
+// gatherHandoffText renders agentAddress's handoff bead and any attached
+// molecule as JSON, or "" if it has no handoff bead yet.
+func gatherHandoffText(beadsPath, agentAddress string) string {
+	parts := strings.Split(agentAddress, "/")
+	role := parts[len(parts)-1]
+
+	b := beads.New(beadsPath)
+	handoff, err := b.FindHandoffBead(role)
+	if err != nil || handoff == nil {
+		return ""
+	}
+
+	data, err := json.MarshalIndent(struct {
+		Handoff    interface{} `json:"handoff"`
+		Attachment interface{} `json:"attachment,omitempty"`
+	}{handoff, beads.ParseAttachmentFields(handoff)}, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("handoff bead %s (failed to encode: %v)", handoff.ID, err)
+	}
+	return string(data)
+}
+
+// gatherInboxSummary renders agentAddress's mailbox as counts plus one line
+// per message (subject, sender, read state), with bodies only if
+// includeMail is set - a doctor bundle should never leak message contents
+// by default.
+func gatherInboxSummary(rigPath, agentAddress string, includeMail bool) string {
+	router := mail.NewRouter(rigPath)
+	mailbox, err := router.GetMailbox(agentAddress)
+	if err != nil {
+		return ""
+	}
+	messages, err := mailbox.Messages()
+	if err != nil {
+		return ""
+	}
+
+	var unread int
+	var lines []string
+	for _, msg := range messages {
+		status := "read"
+		if !msg.Read {
+			status = "unread"
+			unread++
+		}
+		line := fmt.Sprintf("[%s] %s: %q (from %s)", status, msg.Date.Format(time.RFC3339), msg.Subject, msg.From)
+		if includeMail {
+			line += "\n" + msg.Body
+		}
+		lines = append(lines, line)
+	}
+
+	summary := fmt.Sprintf("%s: %d message(s), %d unread", agentAddress, len(messages), unread)
+	if len(lines) == 0 {
+		return summary
+	}
+	return summary + "\n" + strings.Join(lines, "\n")
+}
+
+// polecatClonePath resolves target's clone path, or "" if the rig or
+// polecat can't be found.
+func polecatClonePath(townRoot string, target *SlingTarget) string {
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+
+	rigMgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	r, err := rigMgr.GetRig(target.Rig)
+	if err != nil {
+		return ""
+	}
+
+	polecatMgr := polecat.NewManager(r, git.NewGit(r.Path))
+	p, err := polecatMgr.Get(target.Name)
+	if err != nil {
+		return ""
+	}
+	return p.ClonePath
+}
+
+// gatherVersions returns the gastown and bd version strings, one per line,
+// skipping either if the binary can't be run.
+func gatherVersions() string {
+	var lines []string
+	if out := runCapture("", "gt", "--version"); out != "" {
+		lines = append(lines, "gt: "+out)
+	}
+	if out := runCapture("", "bd", "--version"); out != "" {
+		lines = append(lines, "bd: "+out)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// runCapture runs name with args (in dir, if set) and returns its combined
+// stdout+stderr, trimmed, or "" if the command can't be run - doctor bundles
+// are best-effort and a missing tool shouldn't fail the whole dump.
+func runCapture(dir, name string, args ...string) string {
+	cmd := exec.Command(name, args...)
+	if dir != "" {
+		cmd.Dir = dir
+	}
+	out, _ := cmd.CombinedOutput()
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var slingHookCmd = &cobra.Command{
+	Use:   "hook",
+	Short: "Manage the git-trailer-driven sling post-receive hook",
+}
+
+var slingHookInstallCmd = &cobra.Command{
+	Use:   "install <polecat-clone-path>",
+	Short: "Install the sling post-receive hook in a polecat's clone",
+	Long: `Install a post-receive hook that parses Sling-* git trailers on
+incoming commits and dispatches them through the same routing 'gt sling' uses.
+
+Recognised trailers:
+  Sling-To:       polecat/beta (or deacon/, witness/, refinery/)
+  Sling-Molecule: bugfix
+  Sling-Priority: 1
+  Sling-Wisp:     true
+  Sling-Issue:    gt-123
+
+This lets a developer dispatch work simply by pushing a commit with the
+right trailers, as a natural side effect of code review rather than an
+out-of-band CLI invocation.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSlingHookInstall,
+}
+
+var slingHookRunReceiveCmd = &cobra.Command{
+	Use:    "run-receive",
+	Short:  "Run as a post-receive hook (invoked by the installed hook script)",
+	Hidden: true,
+	RunE:   runSlingHookRunReceive,
+}
+
+func init() {
+	slingHookCmd.AddCommand(slingHookInstallCmd)
+	slingHookCmd.AddCommand(slingHookRunReceiveCmd)
+	slingCmd.AddCommand(slingHookCmd)
+}
+
+const postReceiveHookScript = `#!/bin/sh
+# Installed by 'gt sling hook install'. Parses Sling-* trailers on incoming
+# commits and dispatches them the same way 'gt sling' would.
+exec gt sling hook run-receive
+`
+
+func runSlingHookInstall(cmd *cobra.Command, args []string) error {
+	clonePath := args[0]
+
+	hooksDir := filepath.Join(clonePath, ".git", "hooks")
+	if info, err := os.Stat(filepath.Join(clonePath, ".git")); err == nil && !info.IsDir() {
+		// Worktree clones have a .git file pointing at the real git dir.
+		gitDir, err := resolveWorktreeGitDir(clonePath)
+		if err != nil {
+			return fmt.Errorf("resolving worktree git dir: %w", err)
+		}
+		hooksDir = filepath.Join(gitDir, "hooks")
+	}
+
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return fmt.Errorf("creating hooks dir: %w", err)
+	}
+
+	hookPath := filepath.Join(hooksDir, "post-receive")
+	if err := os.WriteFile(hookPath, []byte(postReceiveHookScript), 0755); err != nil {
+		return fmt.Errorf("writing post-receive hook: %w", err)
+	}
+
+	fmt.Printf("%s Installed post-receive hook at %s\n", style.Bold.Render("✓"), hookPath)
+	return nil
+}
+
+// resolveWorktreeGitDir reads a worktree's .git file to find its real git
+// directory under the main clone's .git/worktrees/<name>.
+func resolveWorktreeGitDir(clonePath string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(clonePath, ".git"))
+	if err != nil {
+		return "", err
+	}
+
+	line := strings.TrimSpace(string(data))
+	const prefix = "gitdir: "
+	if !strings.HasPrefix(line, prefix) {
+		return "", fmt.Errorf("unrecognized .git file format")
+	}
+
+	return strings.TrimPrefix(line, prefix), nil
+}
+
+// runSlingHookRunReceive implements the post-receive side: it reads
+// "<old-sha> <new-sha> <ref>" lines from stdin (the standard post-receive
+// protocol), walks the newly-pushed commits, and dispatches any with
+// recognised Sling-* trailers.
+func runSlingHookRunReceive(cmd *cobra.Command, args []string) error {
+	repoDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 {
+			continue
+		}
+		oldRev, newRev := fields[0], fields[1]
+
+		shas, err := git.RevList(repoDir, oldRev, newRev)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "sling hook: %v\n", err)
+			continue
+		}
+
+		for _, sha := range shas {
+			if err := dispatchTrailerSling(townRoot, repoDir, sha, rigsConfig); err != nil {
+				fmt.Fprintf(os.Stderr, "sling hook: %s: %v\n", sha, err)
+			}
+		}
+	}
+
+	return scanner.Err()
+}
+
+// dispatchTrailerSling parses a commit's Sling-* trailers and, if present
+// and the committer is allow-listed, dispatches the sling exactly as
+// 'gt sling' would, reusing slingToPolecat/slingToDeacon so checkHookCollision
+// and pinToHook behave identically either way.
+func dispatchTrailerSling(townRoot, repoDir, sha string, rigsConfig *config.RigsConfig) error {
+	trailers, err := git.CommitTrailers(repoDir, sha)
+	if err != nil {
+		return fmt.Errorf("parsing trailers: %w", err)
+	}
+
+	slingTo, ok := trailers["Sling-To"]
+	if !ok {
+		return nil // not a sling commit
+	}
+
+	email, err := git.CommitCommitterEmail(repoDir, sha)
+	if err != nil {
+		return fmt.Errorf("resolving committer: %w", err)
+	}
+	if !isSlingAllowlisted(email, rigsConfig) {
+		return fmt.Errorf("committer %s is not on the sling allow-list, skipping", email)
+	}
+
+	target, err := parseSlingTarget(slingTo, townRoot)
+	if err != nil {
+		return fmt.Errorf("invalid Sling-To: %w", err)
+	}
+
+	thingArg := trailers["Sling-Issue"]
+	if thingArg == "" {
+		thingArg = trailers["Sling-Molecule"]
+	}
+	if thingArg == "" {
+		return fmt.Errorf("commit has Sling-To but neither Sling-Issue nor Sling-Molecule")
+	}
+
+	beadsPath := filepath.Join(townRoot, target.Rig)
+	thing, err := parseSlingThing(thingArg, beadsPath)
+	if err != nil {
+		return fmt.Errorf("invalid sling thing %q: %w", thingArg, err)
+	}
+	thing.Proto = trailers["Sling-Molecule"]
+	thing.IsWisp = strings.EqualFold(trailers["Sling-Wisp"], "true")
+
+	if p, ok := trailers["Sling-Priority"]; ok {
+		if pr, err := strconv.Atoi(p); err == nil {
+			slingPriority = pr
+		}
+	}
+
+	fmt.Printf("Sling hook: dispatching %s %s at %s (commit %s)\n", thing.Kind, thing.ID, slingTo, sha[:8])
+
+	switch target.Kind {
+	case "polecat":
+		return slingToPolecat(townRoot, target, thing, SlingOptions{}, nil)
+	case "deacon":
+		return slingToDeacon(townRoot, target, thing, SlingOptions{}, nil)
+	default:
+		return fmt.Errorf("Sling-To target kind %q not supported from hooks", target.Kind)
+	}
+}
+
+// isSlingAllowlisted reports whether email may trigger trailer-driven slings,
+// per the allow-list configured in mayor/rigs.json.
+func isSlingAllowlisted(email string, rigsConfig *config.RigsConfig) bool {
+	for _, allowed := range rigsConfig.SlingAllowlist {
+		if strings.EqualFold(allowed, email) {
+			return true
+		}
+	}
+	return false
+}
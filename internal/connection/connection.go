@@ -0,0 +1,185 @@
+// Package connection stores and dials named remote Gas Town endpoints, so
+// operators can sling work at polecats living in other towns without
+// SSH'ing in first.
+package connection
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Connection is a named remote Gas Town endpoint reachable over SSH.
+type Connection struct {
+	Name string `json:"name"`
+
+	// URI is the SSH endpoint, e.g. "deploy@gastown-east.example.com:22".
+	URI string `json:"uri"`
+
+	// IdentityFile is an optional path to a private key. If empty, the
+	// ssh-agent (AgentSocket, or $SSH_AUTH_SOCK) is used instead.
+	IdentityFile string `json:"identity_file,omitempty"`
+
+	// AgentSocket overrides $SSH_AUTH_SOCK for this connection.
+	AgentSocket string `json:"agent_socket,omitempty"`
+
+	// HostKey pins the remote SSH host's public key, in authorized_keys
+	// format (e.g. "ssh-ed25519 AAAA..."). Empty until the first successful
+	// dial, which trusts and records whatever key the server presents
+	// (trust-on-first-use) so every later dial is verified against it
+	// instead of skipping host key verification outright.
+	HostKey string `json:"host_key,omitempty"`
+
+	// Fingerprint is the expected remote town's git-remote hash (see
+	// `gt sling --print-fingerprint`). Remote slings refuse to run if the
+	// fingerprint observed on the far side doesn't match. This is an
+	// identity check for catching a connection repointed at the wrong
+	// town, not a cryptographic signature - it only resists tampering once
+	// HostKey has authenticated the channel it's read over.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// Roots are candidate town roots to search for on the remote host when
+	// resolving townRoot there. If empty, the remote's default search applies.
+	Roots []string `json:"roots,omitempty"`
+}
+
+// Store is the on-disk set of configured connections.
+type Store struct {
+	Connections map[string]Connection `json:"connections"`
+	Default     string                 `json:"default,omitempty"`
+
+	path string
+}
+
+// configPath returns ~/.config/gastown/connections.json.
+func configPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("finding home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "gastown", "connections.json"), nil
+}
+
+// Load reads the connection store, returning an empty one if it doesn't
+// exist yet.
+func Load() (*Store, error) {
+	path, err := configPath()
+	if err != nil {
+		return nil, err
+	}
+
+	store := &Store{Connections: make(map[string]Connection), path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return store, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	store.path = path
+	if store.Connections == nil {
+		store.Connections = make(map[string]Connection)
+	}
+
+	return store, nil
+}
+
+// Save writes the connection store back to disk.
+func (s *Store) Save() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("creating config dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding connections: %w", err)
+	}
+
+	return os.WriteFile(s.path, data, 0600)
+}
+
+// Add registers or replaces a connection.
+func (s *Store) Add(c Connection) error {
+	if c.Name == "" {
+		return fmt.Errorf("connection name is required")
+	}
+	if c.URI == "" {
+		return fmt.Errorf("connection URI is required")
+	}
+	if c.HostKey != "" {
+		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(c.HostKey)); err != nil {
+			return fmt.Errorf("invalid host key: %w", err)
+		}
+	}
+	s.Connections[c.Name] = c
+	if s.Default == "" {
+		s.Default = c.Name
+	}
+	return s.Save()
+}
+
+// Get returns a connection by name, falling back to the default connection
+// if name is empty.
+func (s *Store) Get(name string) (Connection, error) {
+	if name == "" {
+		name = s.Default
+	}
+	if name == "" {
+		return Connection{}, fmt.Errorf("no connection specified and no default set")
+	}
+	c, ok := s.Connections[name]
+	if !ok {
+		return Connection{}, fmt.Errorf("connection %q not found", name)
+	}
+	return c, nil
+}
+
+// Remove deletes a connection, clearing Default if it pointed at it.
+func (s *Store) Remove(name string) error {
+	if _, ok := s.Connections[name]; !ok {
+		return fmt.Errorf("connection %q not found", name)
+	}
+	delete(s.Connections, name)
+	if s.Default == name {
+		s.Default = ""
+	}
+	return s.Save()
+}
+
+// Rename changes a connection's key without touching its settings.
+func (s *Store) Rename(oldName, newName string) error {
+	c, ok := s.Connections[oldName]
+	if !ok {
+		return fmt.Errorf("connection %q not found", oldName)
+	}
+	if _, exists := s.Connections[newName]; exists {
+		return fmt.Errorf("connection %q already exists", newName)
+	}
+
+	c.Name = newName
+	s.Connections[newName] = c
+	delete(s.Connections, oldName)
+	if s.Default == oldName {
+		s.Default = newName
+	}
+
+	return s.Save()
+}
+
+// SetDefault marks name as the default connection used when --connection is
+// omitted but GT_CONNECTION-style remote dispatch is otherwise requested.
+func (s *Store) SetDefault(name string) error {
+	if _, ok := s.Connections[name]; !ok {
+		return fmt.Errorf("connection %q not found", name)
+	}
+	s.Default = name
+	return s.Save()
+}
@@ -0,0 +1,195 @@
+package connection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// pinnedHostKeyCallback returns an ssh.HostKeyCallback that verifies the
+// remote host's key against c.HostKey. If c has no pinned key yet (its
+// first dial), it trusts whatever key the server presents and persists it
+// to store under c's name, the same trust-on-first-use model ssh's own
+// known_hosts uses - every later dial is checked against the pinned key
+// instead of skipping verification outright.
+func pinnedHostKeyCallback(store *Store, c Connection) (ssh.HostKeyCallback, error) {
+	var pinned ssh.PublicKey
+	if c.HostKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(c.HostKey))
+		if err != nil {
+			return nil, fmt.Errorf("parsing pinned host key for connection %q: %w", c.Name, err)
+		}
+		pinned = key
+	}
+
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if pinned != nil {
+			if !bytes.Equal(pinned.Marshal(), key.Marshal()) {
+				return fmt.Errorf("host key mismatch for connection %q: remote presented %s, pinned %s (remove and re-add the connection only after confirming the new key out of band)",
+					c.Name, ssh.FingerprintSHA256(key), ssh.FingerprintSHA256(pinned))
+			}
+			return nil
+		}
+
+		c.HostKey = string(ssh.MarshalAuthorizedKey(key))
+		if store != nil {
+			if err := store.Add(c); err != nil {
+				return fmt.Errorf("pinning host key for connection %q: %w", c.Name, err)
+			}
+		}
+		fmt.Fprintf(os.Stderr, "Pinned host key for connection %q (%s)\n", c.Name, ssh.FingerprintSHA256(key))
+		return nil
+	}, nil
+}
+
+// RemoteSlingRequest is the payload sent to `gt sling --stdin-request` on
+// the far side: the raw <thing>/<target> arguments plus flags, so the
+// remote gt reparses and validates them against its own beads state rather
+// than trusting anything computed locally.
+type RemoteSlingRequest struct {
+	ThingArg  string `json:"thing_arg"`
+	TargetArg string `json:"target_arg"`
+	Molecule  string `json:"molecule,omitempty"`
+	Priority  int    `json:"priority"`
+	Wisp      bool   `json:"wisp"`
+	Force     bool   `json:"force"`
+	NoStart   bool   `json:"no_start"`
+	Create    bool   `json:"create"`
+}
+
+// dialSSH opens an SSH connection authenticated via the connection's
+// identity file or an ssh-agent socket. store, if non-nil, is where a
+// first-use host key gets pinned back to (see pinnedHostKeyCallback).
+func dialSSH(store *Store, c Connection) (*ssh.Client, error) {
+	var authMethods []ssh.AuthMethod
+
+	socket := c.AgentSocket
+	if socket == "" {
+		socket = os.Getenv("SSH_AUTH_SOCK")
+	}
+	if socket != "" {
+		if conn, err := net.Dial("unix", socket); err == nil {
+			authMethods = append(authMethods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+
+	if c.IdentityFile != "" {
+		key, err := os.ReadFile(c.IdentityFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading identity file: %w", err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("parsing identity file: %w", err)
+		}
+		authMethods = append(authMethods, ssh.PublicKeys(signer))
+	}
+
+	if len(authMethods) == 0 {
+		return nil, fmt.Errorf("no SSH authentication method available for connection %q", c.Name)
+	}
+
+	hostKeyCallback, err := pinnedHostKeyCallback(store, c)
+	if err != nil {
+		return nil, err
+	}
+
+	user, hostPort := splitURI(c.URI)
+	config := &ssh.ClientConfig{
+		User:            user,
+		Auth:            authMethods,
+		HostKeyCallback: hostKeyCallback,
+	}
+
+	return ssh.Dial("tcp", hostPort, config)
+}
+
+// splitURI splits a "user@host[:port]" connection URI, defaulting to port 22.
+func splitURI(uri string) (user, hostPort string) {
+	if idx := strings.Index(uri, "@"); idx >= 0 {
+		user, hostPort = uri[:idx], uri[idx+1:]
+	} else {
+		hostPort = uri
+	}
+	if !strings.Contains(hostPort, ":") {
+		hostPort += ":22"
+	}
+	return user, hostPort
+}
+
+// RunRemoteSling dials c over a host-key-verified connection (see
+// pinnedHostKeyCallback), checks the remote town's fingerprint (when one is
+// recorded), then streams req to `gt sling --stdin-request` over SSH,
+// copying stdout/stderr back to the caller as they arrive. store is where a
+// first-use host key pin is persisted; pass nil only when c's pin shouldn't
+// be saved (e.g. a one-off dial outside the normal connections.json flow).
+func RunRemoteSling(store *Store, c Connection, req RemoteSlingRequest, stdout, stderr io.Writer) error {
+	client, err := dialSSH(store, c)
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", c.Name, err)
+	}
+	defer client.Close()
+
+	if c.Fingerprint != "" {
+		if err := verifyFingerprint(client, c); err != nil {
+			return err
+		}
+	}
+
+	session, err := client.NewSession()
+	if err != nil {
+		return fmt.Errorf("opening session: %w", err)
+	}
+	defer session.Close()
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("encoding request: %w", err)
+	}
+
+	session.Stdin = bytes.NewReader(payload)
+	session.Stdout = stdout
+	session.Stderr = stderr
+
+	remoteCmd := "gt sling --stdin-request"
+	for _, root := range c.Roots {
+		remoteCmd += " --root " + root
+	}
+
+	return session.Run(remoteCmd)
+}
+
+// verifyFingerprint refuses to dispatch if the remote town's fingerprint
+// doesn't match the one recorded for c, so a connections.json entry can't
+// be silently repointed at a different town. This is only a meaningful
+// check because client is already host-key-verified (pinnedHostKeyCallback):
+// the fingerprint itself is a plain hash, not a signature, so reading it
+// over an unauthenticated channel would let an active MITM relay the RPC
+// and pass regardless of what's recorded.
+func verifyFingerprint(client *ssh.Client, c Connection) error {
+	session, err := client.NewSession()
+	if err != nil {
+		return fmt.Errorf("opening fingerprint session: %w", err)
+	}
+	defer session.Close()
+
+	var out bytes.Buffer
+	session.Stdout = &out
+	if err := session.Run("gt sling --print-fingerprint"); err != nil {
+		return fmt.Errorf("reading remote fingerprint: %w", err)
+	}
+
+	remote := strings.TrimSpace(out.String())
+	if remote != c.Fingerprint {
+		return fmt.Errorf("fingerprint mismatch for connection %q: expected %s, got %s", c.Name, c.Fingerprint, remote)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package connection
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// TownFingerprint identifies a town by its git remote, so a connections.json
+// entry can't silently end up pointed at a different town than the one an
+// operator signed off on. It's a sha256 of the "origin" remote URL rather
+// than the URL itself, so `gt sling --print-fingerprint` doesn't leak the
+// remote's host or path to whoever reads the output.
+//
+// This is a plain hash of public, guessable data, not a signature - it
+// only catches an accidental or unauthenticated repointing, and only once
+// it's read back over a host-key-verified connection (see
+// pinnedHostKeyCallback in dial.go); it provides no protection on its own
+// against an adversary who can already intercept the SSH session.
+func TownFingerprint(townRoot string) (string, error) {
+	cmd := exec.Command("git", "-C", townRoot, "remote", "get-url", "origin")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("reading town git remote: %w", err)
+	}
+
+	remote := strings.TrimSpace(string(out))
+	sum := sha256.Sum256([]byte(remote))
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,163 @@
+// Package doctor assembles diagnostic support bundles for failed `gt sling`
+// dispatches - the git/tmux/mailbox/handoff context an operator would
+// otherwise be asked to paste by hand when filing a bug, collected
+// automatically and redacted of anything sensitive.
+package doctor
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Input is everything Build needs to assemble a bundle. Callers gather each
+// field using whatever package already knows how (git, tmux, mail, beads),
+// so this package stays a pure formatter/redactor/archiver.
+type Input struct {
+	Thing  interface{} // JSON-marshalable SlingThing, or nil
+	Target interface{} // JSON-marshalable SlingTarget, or nil
+
+	MolOutput    string // tail of the last `bd mol run` stdout/stderr
+	GitStatus    string // `git status` in the polecat clone
+	GitLog       string // `git log -5`
+	StashList    string // `git stash list`
+	Handoff      string // handoff bead + attachment fields, as text/JSON
+	InboxSummary string // target agent's inbox: counts + subjects (+ bodies if Options.IncludeMail)
+	TmuxLs       string // `tmux ls`
+	Versions     string // gastown/bd version strings
+
+	Err error // the error that triggered an automatic dump, if any
+}
+
+// Options controls how sensitive a bundle is allowed to be.
+type Options struct {
+	// IncludeMail includes message bodies in InboxSummary. Without it,
+	// only counts and subjects are ever collected by callers.
+	IncludeMail bool
+}
+
+// File is one named file inside a Bundle.
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Bundle is a collected, redacted set of diagnostic files ready to archive.
+type Bundle struct {
+	Files []File
+}
+
+// Build assembles a redacted Bundle from in.
+func Build(in Input, opts Options) (*Bundle, error) {
+	b := &Bundle{}
+
+	if in.Thing != nil || in.Target != nil {
+		combined := struct {
+			Thing  interface{} `json:"thing,omitempty"`
+			Target interface{} `json:"target,omitempty"`
+		}{in.Thing, in.Target}
+		data, err := json.MarshalIndent(combined, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encoding thing/target: %w", err)
+		}
+		b.add("sling.json", string(data))
+	}
+
+	if in.Err != nil {
+		b.add("error.txt", in.Err.Error())
+	}
+
+	b.add("mol-output.txt", in.MolOutput)
+	b.add("git-status.txt", in.GitStatus)
+	b.add("git-log.txt", in.GitLog)
+	b.add("git-stash-list.txt", in.StashList)
+	b.add("handoff.txt", in.Handoff)
+	b.add("inbox.txt", in.InboxSummary)
+	b.add("tmux-ls.txt", in.TmuxLs)
+	b.add("versions.txt", in.Versions)
+
+	_ = opts // reserved for future redaction levels beyond Redact's default
+
+	return b, nil
+}
+
+// add redacts content and appends it as a named file.
+func (b *Bundle) add(name, content string) {
+	b.Files = append(b.Files, File{Name: name, Data: []byte(Redact(content))})
+}
+
+// homeDirRedaction matches an absolute path rooted at a /home/<user> or
+// /Users/<user> directory, so bundles don't leak the operator's username.
+var homeDirRedaction = regexp.MustCompile(`(?:/home/|/Users/)[^/\s]+`)
+
+// slingTokenHeaderRedaction matches a "Sling-Token: ..." mail header line.
+var slingTokenHeaderRedaction = regexp.MustCompile(`(?im)^(Sling-Token:\s*).+$`)
+
+// Redact strips absolute home-directory paths and Sling-Token mail headers
+// from s before it's written into a bundle.
+func Redact(s string) string {
+	s = homeDirRedaction.ReplaceAllString(s, "~")
+	s = slingTokenHeaderRedaction.ReplaceAllString(s, "${1}[redacted]")
+	return s
+}
+
+// WriteTarGz writes the bundle as a gzipped tar archive to w.
+func (b *Bundle) WriteTarGz(w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	for _, f := range b.Files {
+		hdr := &tar.Header{
+			Name:    f.Name,
+			Mode:    0644,
+			Size:    int64(len(f.Data)),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("writing %s header: %w", f.Name, err)
+		}
+		if _, err := tw.Write(f.Data); err != nil {
+			return fmt.Errorf("writing %s: %w", f.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing tar writer: %w", err)
+	}
+	return gw.Close()
+}
+
+// Upload POSTs the bundle's tar.gz bytes to url as application/gzip.
+func Upload(url string, b *Bundle) error {
+	var buf bytes.Buffer
+	if err := b.WriteTarGz(&buf); err != nil {
+		return err
+	}
+
+	resp, err := http.Post(url, "application/gzip", &buf)
+	if err != nil {
+		return fmt.Errorf("uploading to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("upload to %s failed: %s", url, resp.Status)
+	}
+	return nil
+}
+
+// TailLines returns the last n lines of s (fewer if s has fewer).
+func TailLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) <= n {
+		return s
+	}
+	return strings.Join(lines[len(lines)-n:], "\n")
+}
@@ -0,0 +1,95 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Trailers represents structured "Key: value" Git trailers parsed from a
+// commit message, as produced by `git interpret-trailers --parse`.
+type Trailers map[string]string
+
+// RevList returns the commit SHAs reachable from newRef but not oldRef, in
+// git rev-list's default (reverse-chronological) order. oldRef may be the
+// all-zeros SHA, as pushed for a new branch, in which case every commit
+// reachable from newRef is returned.
+func RevList(repoDir, oldRef, newRef string) ([]string, error) {
+	rangeArg := newRef
+	if oldRef != "" && !isZeroSHA(oldRef) {
+		rangeArg = oldRef + ".." + newRef
+	}
+
+	cmd := exec.Command("git", "rev-list", rangeArg)
+	cmd.Dir = repoDir
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git rev-list %s: %w", rangeArg, err)
+	}
+
+	trimmed := strings.TrimSpace(out.String())
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	return strings.Split(trimmed, "\n"), nil
+}
+
+func isZeroSHA(sha string) bool {
+	for _, c := range sha {
+		if c != '0' {
+			return false
+		}
+	}
+	return true
+}
+
+// CommitTrailers extracts "key: value" trailers from a commit's message via
+// `git interpret-trailers --parse`.
+func CommitTrailers(repoDir, sha string) (Trailers, error) {
+	show := exec.Command("git", "show", "-s", "--format=%B", sha)
+	show.Dir = repoDir
+	var body bytes.Buffer
+	show.Stdout = &body
+	if err := show.Run(); err != nil {
+		return nil, fmt.Errorf("git show %s: %w", sha, err)
+	}
+
+	parse := exec.Command("git", "interpret-trailers", "--parse")
+	parse.Dir = repoDir
+	parse.Stdin = strings.NewReader(body.String())
+	var out bytes.Buffer
+	parse.Stdout = &out
+	if err := parse.Run(); err != nil {
+		return nil, fmt.Errorf("git interpret-trailers: %w", err)
+	}
+
+	trailers := make(Trailers)
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		trailers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	return trailers, nil
+}
+
+// CommitCommitterEmail returns the committer email for a commit, used to
+// check against an allow-list before acting on its trailers.
+func CommitCommitterEmail(repoDir, sha string) (string, error) {
+	cmd := exec.Command("git", "show", "-s", "--format=%ce", sha)
+	cmd.Dir = repoDir
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git show %s: %w", sha, err)
+	}
+	return strings.TrimSpace(out.String()), nil
+}
@@ -0,0 +1,152 @@
+// Package mail implements polecat mailboxes: small per-address inboxes used
+// to deliver work assignments and notifications, routed by agent address
+// (e.g. "gastown/polecat/alpha", "gastown/witness").
+package mail
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Message is a single mail message delivered to an agent's mailbox.
+type Message struct {
+	ID      string `json:"id"`
+	To      string `json:"to"`
+	From    string `json:"from"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+
+	// Headers carries additional headers beyond the standard ones above,
+	// such as "X-Gastown-Molecule" or "X-Gastown-Attachment", so routing
+	// metadata survives alongside the human-readable message.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	Date time.Time `json:"date"`
+	Read bool      `json:"read"`
+}
+
+// Mailbox is one agent's inbox, backed by a directory of one JSON file per
+// message.
+type Mailbox struct {
+	Address string
+	dir     string
+}
+
+// Router delivers mail to and reads mail from mailboxes rooted under a rig
+// (or town) path.
+type Router struct {
+	root string
+}
+
+// NewRouter creates a Router whose mailboxes live under <root>/mail/.
+func NewRouter(root string) *Router {
+	return &Router{root: root}
+}
+
+// mailboxDir returns the on-disk directory for an address, with path
+// separators in the address replaced so it's safe as a single path segment.
+func (r *Router) mailboxDir(address string) string {
+	safe := strings.ReplaceAll(address, "/", "_")
+	return filepath.Join(r.root, "mail", safe, "inbox")
+}
+
+// GetMailbox returns the mailbox for address, creating its directory if
+// necessary.
+func (r *Router) GetMailbox(address string) (*Mailbox, error) {
+	dir := r.mailboxDir(address)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating mailbox dir: %w", err)
+	}
+	return &Mailbox{Address: address, dir: dir}, nil
+}
+
+// Send delivers msg to its To address's mailbox.
+func (r *Router) Send(msg *Message) error {
+	mailbox, err := r.GetMailbox(msg.To)
+	if err != nil {
+		return err
+	}
+	return mailbox.deliver(msg)
+}
+
+// deliver writes msg as a new file in the mailbox, assigning it an ID and
+// Date if not already set.
+func (m *Mailbox) deliver(msg *Message) error {
+	if msg.Date.IsZero() {
+		msg.Date = time.Now()
+	}
+	if msg.ID == "" || !isSafeMessageID(msg.ID) {
+		msg.ID = fmt.Sprintf("%d.%s", msg.Date.UnixNano(), strconv.Itoa(os.Getpid()))
+	}
+
+	data, err := json.MarshalIndent(msg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding message: %w", err)
+	}
+
+	path := filepath.Join(m.dir, msg.ID+".json")
+	return os.WriteFile(path, data, 0644)
+}
+
+// isSafeMessageID reports whether id is safe to use as a mailbox filename
+// stem. msg.ID can come from outside this process - an imported mbox's
+// Message-Id header - so a value containing a path separator or a ".."
+// segment must be rejected rather than joined straight into a filesystem
+// path.
+func isSafeMessageID(id string) bool {
+	if id == "" || id == "." || id == ".." {
+		return false
+	}
+	return !strings.ContainsAny(id, `/\`)
+}
+
+// Messages returns every message in the mailbox, oldest first.
+func (m *Mailbox) Messages() ([]*Message, error) {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading mailbox: %w", err)
+	}
+
+	var messages []*Message
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(m.dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		var msg Message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+		messages = append(messages, &msg)
+	}
+
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Date.Before(messages[j].Date) })
+	return messages, nil
+}
+
+// Count returns the total and unread message counts in the mailbox.
+func (m *Mailbox) Count() (total, unread int, err error) {
+	messages, err := m.Messages()
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, msg := range messages {
+		total++
+		if !msg.Read {
+			unread++
+		}
+	}
+	return total, unread, nil
+}
@@ -0,0 +1,209 @@
+package mail
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// mboxDateFormat is the traditional ctime-style timestamp used in mbox
+// "From " separator lines (e.g. "Mon Jan 2 15:04:05 2006").
+const mboxDateFormat = "Mon Jan 2 15:04:05 2006"
+
+// ExportMbox writes every message in address's mailbox to w using RFC 4155
+// ("mbox with From_ and >From quoting") framing, so the result can be read
+// with standard mail tooling (mutt, mailutils, etc.) and round-tripped back
+// in with ImportMbox.
+func (r *Router) ExportMbox(address string, w io.Writer) error {
+	mailbox, err := r.GetMailbox(address)
+	if err != nil {
+		return err
+	}
+
+	messages, err := mailbox.Messages()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range messages {
+		if err := writeMboxMessage(w, msg); err != nil {
+			return fmt.Errorf("writing message %s: %w", msg.ID, err)
+		}
+	}
+	return nil
+}
+
+func writeMboxMessage(w io.Writer, msg *Message) error {
+	from := msg.From
+	if from == "" {
+		from = "gastown"
+	}
+
+	if _, err := fmt.Fprintf(w, "From %s %s\n", mboxFromToken(from), msg.Date.UTC().Format(mboxDateFormat)); err != nil {
+		return err
+	}
+
+	headerLines := []string{
+		fmt.Sprintf("Date: %s", msg.Date.UTC().Format(time.RFC1123Z)),
+		fmt.Sprintf("Message-Id: <%s@gastown>", msg.ID),
+		fmt.Sprintf("From: %s", msg.From),
+		fmt.Sprintf("To: %s", msg.To),
+		fmt.Sprintf("Subject: %s", msg.Subject),
+	}
+
+	// Custom headers (X-Gastown-Molecule, X-Gastown-Attachment, etc.) are
+	// written in sorted order so exports are diffable.
+	var customKeys []string
+	for k := range msg.Headers {
+		customKeys = append(customKeys, k)
+	}
+	sort.Strings(customKeys)
+	for _, k := range customKeys {
+		headerLines = append(headerLines, fmt.Sprintf("%s: %s", k, msg.Headers[k]))
+	}
+	if msg.Read {
+		headerLines = append(headerLines, "X-Gastown-Read: true")
+	}
+
+	for _, line := range headerLines {
+		if _, err := fmt.Fprintf(w, "%s\n", line); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprint(w, "\n"); err != nil {
+		return err
+	}
+
+	body := strings.ReplaceAll(msg.Body, "\r\n", "\n")
+	for _, line := range strings.Split(body, "\n") {
+		if _, err := fmt.Fprintf(w, "%s\n", escapeMboxFromLine(line)); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprint(w, "\n"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// mboxFromToken sanitizes the sender for the "From " separator line, which
+// has no quoting of its own - it must not itself start a line with "From ".
+func mboxFromToken(from string) string {
+	return strings.ReplaceAll(from, " ", "-")
+}
+
+// escapeMboxFromLine prepends ">" to any body line that would otherwise be
+// mistaken for a "From " separator by a naive mbox reader, per RFC 4155's
+// ">From" quoting convention. Lines already starting with one or more ">"
+// immediately before "From " get an additional ">", so unescaping is
+// unambiguous.
+func escapeMboxFromLine(line string) string {
+	trimmed := strings.TrimLeft(line, ">")
+	if strings.HasPrefix(trimmed, "From ") {
+		return ">" + line
+	}
+	return line
+}
+
+// unescapeMboxFromLine reverses escapeMboxFromLine.
+func unescapeMboxFromLine(line string) string {
+	if strings.HasPrefix(line, ">") {
+		rest := line[1:]
+		trimmed := strings.TrimLeft(rest, ">")
+		if strings.HasPrefix(trimmed, "From ") {
+			return rest
+		}
+	}
+	return line
+}
+
+// ImportMbox reads messages framed as ExportMbox writes them and delivers
+// each into address's mailbox.
+func (r *Router) ImportMbox(address string, rd io.Reader) error {
+	mailbox, err := r.GetMailbox(address)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(rd)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+
+	var current *Message
+	var body []string
+	inHeaders := false
+
+	flush := func() error {
+		if current == nil {
+			return nil
+		}
+		current.Body = strings.TrimSuffix(strings.Join(body, "\n"), "\n")
+		return mailbox.deliver(current)
+	}
+
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if strings.HasPrefix(line, "From ") {
+			if err := flush(); err != nil {
+				return fmt.Errorf("delivering message: %w", err)
+			}
+			current = &Message{To: address, Headers: make(map[string]string)}
+			body = nil
+			inHeaders = true
+			continue
+		}
+
+		if current == nil {
+			continue // junk before the first "From " line
+		}
+
+		if inHeaders {
+			if line == "" {
+				inHeaders = false
+				continue
+			}
+			key, value, ok := strings.Cut(line, ":")
+			if !ok {
+				inHeaders = false
+			} else {
+				applyMboxHeader(current, strings.TrimSpace(key), strings.TrimSpace(value))
+				continue
+			}
+		}
+
+		body = append(body, unescapeMboxFromLine(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading mbox: %w", err)
+	}
+
+	return flush()
+}
+
+// applyMboxHeader assigns a parsed header line to msg, routing the standard
+// headers to their fields and everything else (including X-Gastown-*) into
+// Headers so custom metadata round-trips.
+func applyMboxHeader(msg *Message, key, value string) {
+	switch strings.ToLower(key) {
+	case "date":
+		if t, err := time.Parse(time.RFC1123Z, value); err == nil {
+			msg.Date = t
+		}
+	case "message-id":
+		id := strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+		msg.ID = strings.TrimSuffix(id, "@gastown")
+	case "from":
+		msg.From = value
+	case "to":
+		msg.To = value
+	case "subject":
+		msg.Subject = value
+	case "x-gastown-read":
+		msg.Read = value == "true"
+	default:
+		msg.Headers[key] = value
+	}
+}
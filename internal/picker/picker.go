@@ -0,0 +1,164 @@
+// Package picker renders an interactive tmux display-menu for choosing
+// among a rig's polecats/sessions, grouped by state, with keybindings for
+// the common actions (attach, finish, stop, reset).
+package picker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// State groups items in the rendered menu.
+type State string
+
+const (
+	StateIdle    State = "idle"
+	StateWorking State = "working"
+	StateStuck   State = "stuck"
+	StateDone    State = "done"
+)
+
+// Item is a single row in the picker.
+type Item struct {
+	Name     string // polecat name
+	State    State
+	Issue    string // assigned issue ID, if any
+	Attached bool
+}
+
+// Action is what the user chose to do with the selected item.
+type Action string
+
+const (
+	ActionAttach Action = "attach"
+	ActionFinish Action = "finish"
+	ActionStop   Action = "stop"
+	ActionReset  Action = "reset"
+)
+
+// Result is the outcome of a Pick.
+type Result struct {
+	Name   string
+	Action Action
+}
+
+// ErrCancelled is returned when the user dismisses the menu without choosing.
+var ErrCancelled = fmt.Errorf("picker cancelled")
+
+// stateOrder controls the grouping order in the rendered menu.
+var stateOrder = []State{StateWorking, StateStuck, StateIdle, StateDone}
+
+// Show renders a tmux display-menu grouping items by state (idle/working/
+// stuck/done) with attached-status and issue annotations, and blocks until
+// the user picks an item+action or dismisses the menu. It must be run from
+// a context with an attached tmux client (e.g. inside a polecat session).
+func Show(title string, items []Item) (*Result, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("nothing to pick from")
+	}
+	for _, it := range items {
+		if !isSafeName(it.Name) {
+			return nil, fmt.Errorf("invalid item name %q", it.Name)
+		}
+	}
+
+	resultFile, err := os.CreateTemp("", "gt-picker-*.txt")
+	if err != nil {
+		return nil, fmt.Errorf("creating result file: %w", err)
+	}
+	resultPath := resultFile.Name()
+	resultFile.Close()
+	defer os.Remove(resultPath)
+
+	waitChannel := fmt.Sprintf("gt-picker-%d", os.Getpid())
+
+	args := []string{"display-menu", "-T", title}
+	for _, state := range stateOrder {
+		var grouped []Item
+		for _, it := range items {
+			if it.State == state {
+				grouped = append(grouped, it)
+			}
+		}
+		if len(grouped) == 0 {
+			continue
+		}
+
+		args = append(args, strings.ToUpper(string(state[:1]))+string(state[1:]), "", "")
+		for _, it := range grouped {
+			label := it.Name
+			if it.Issue != "" {
+				label += fmt.Sprintf(" (%s)", it.Issue)
+			}
+			if it.Attached {
+				label += " *"
+			}
+
+			for _, row := range []struct {
+				key    string
+				action Action
+				verb   string
+			}{
+				{"a", ActionAttach, "attach"},
+				{"f", ActionFinish, "finish"},
+				{"x", ActionStop, "stop"},
+				{"r", ActionReset, "reset"},
+			} {
+				args = append(args,
+					fmt.Sprintf("%s: %s", label, row.verb), row.key,
+					writeResultCmd(resultPath, waitChannel, it.Name, row.action),
+				)
+			}
+		}
+	}
+
+	if err := exec.Command("tmux", args...).Run(); err != nil {
+		return nil, fmt.Errorf("displaying menu: %w", err)
+	}
+
+	if err := exec.Command("tmux", "wait-for", waitChannel).Run(); err != nil {
+		return nil, fmt.Errorf("waiting for selection: %w", err)
+	}
+
+	data, err := os.ReadFile(resultPath)
+	if err != nil || len(strings.TrimSpace(string(data))) == 0 {
+		return nil, ErrCancelled
+	}
+
+	parts := strings.SplitN(strings.TrimSpace(string(data)), ":", 2)
+	if len(parts) != 2 {
+		return nil, ErrCancelled
+	}
+
+	return &Result{Name: parts[0], Action: Action(parts[1])}, nil
+}
+
+// writeResultCmd builds the run-shell command for a menu entry: it records
+// the chosen name/action pair and signals the wait-for channel so Show can
+// unblock. name is interpolated into the run-shell '...' string, so Show
+// validates every item's name against isSafeName before this is ever called.
+func writeResultCmd(resultPath, waitChannel, name string, action Action) string {
+	return fmt.Sprintf("run-shell 'echo %s:%s > %s; tmux wait-for -S %s'",
+		name, action, resultPath, waitChannel)
+}
+
+// isSafeName reports whether s is safe to interpolate into a shell command
+// string: letters, digits, dash, underscore, and dot only. This excludes
+// every shell metacharacter, in particular the single quote writeResultCmd's
+// run-shell argument is wrapped in, so a validated name can't break out of it.
+func isSafeName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-' || r == '_' || r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -1,16 +1,20 @@
 package polecat
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/picker"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
 )
 
 // Common errors
@@ -61,6 +65,42 @@ func NewManager(r *rig.Rig, g *git.Git) *Manager {
 	}
 }
 
+// splitQualifiedName splits a fully-qualified "rig/name" polecat identifier
+// into its rig and bare name parts. If name has no slash, rigName is "".
+func splitQualifiedName(name string) (rigName, bare string) {
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		return name[:idx], name[idx+1:]
+	}
+	return "", name
+}
+
+// managerForName resolves the Manager that should handle a (possibly
+// rig-qualified) polecat name. If name is unqualified or qualified with this
+// manager's own rig, m is returned unchanged along with the bare name.
+// Otherwise a Manager for the named rig is constructed so callers can
+// address any rig's polecats without building a new Manager themselves.
+func (m *Manager) managerForName(name string) (*Manager, string, error) {
+	rigName, bare := splitQualifiedName(name)
+	if rigName == "" || rigName == m.rig.Name {
+		return m, bare, nil
+	}
+
+	townRoot := filepath.Dir(m.rig.Path)
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("loading rigs config: %w", err)
+	}
+
+	rigMgr := rig.NewManager(townRoot, rigsConfig, m.git)
+	r, err := rigMgr.GetRig(rigName)
+	if err != nil {
+		return nil, "", fmt.Errorf("rig '%s' not found", rigName)
+	}
+
+	return NewManager(r, git.NewGit(r.Path)), bare, nil
+}
+
 // assigneeID returns the beads assignee identifier for a polecat.
 // Format: "rig/polecatName" (e.g., "gastown/Toast")
 func (m *Manager) assigneeID(name string) string {
@@ -140,9 +180,19 @@ func (m *Manager) Add(name string) (*Polecat, error) {
 	return polecat, nil
 }
 
-// Remove deletes a polecat worktree.
+// Remove deletes a polecat worktree. name may be a bare name or a
+// "rig/name" qualified identifier.
 // If force is true, removes even with uncommitted changes.
 func (m *Manager) Remove(name string, force bool) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Remove(bare, force)
+	}
+	name = bare
+
 	if !m.exists(name) {
 		return ErrPolecatNotFound
 	}
@@ -181,6 +231,37 @@ func (m *Manager) Remove(name string, force bool) error {
 	return nil
 }
 
+// Recreate replaces a polecat's worktree with a fresh one in the same slot:
+// it clears any bind mounts tooling left under the old worktree, removes
+// it, then adds a new one under the same name. The unmount pass has to run
+// before Remove, since Remove's os.RemoveAll fallback can't clear a busy
+// mount on its own. name may be a bare name or a "rig/name" qualified
+// identifier.
+func (m *Manager) Recreate(name string, force bool) (*Polecat, error) {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return nil, err
+	}
+	if target != m {
+		return target.Recreate(bare, force)
+	}
+	name = bare
+
+	if !m.exists(name) {
+		return nil, ErrPolecatNotFound
+	}
+
+	if err := unmountUnder(m.polecatDir(name)); err != nil {
+		return nil, fmt.Errorf("clearing mounts: %w", err)
+	}
+
+	if err := m.Remove(name, force); err != nil {
+		return nil, fmt.Errorf("removing old worktree: %w", err)
+	}
+
+	return m.Add(name)
+}
+
 // AllocateName allocates a name from the name pool.
 // Returns a pooled name (polecat-01 through polecat-50) if available,
 // otherwise returns an overflow name (rigname-N).
@@ -257,26 +338,43 @@ func (m *Manager) List() ([]*Polecat, error) {
 	return polecats, nil
 }
 
-// Get returns a specific polecat by name.
+// Get returns a specific polecat by name. Name may be a bare name (resolved
+// in this manager's rig) or a "rig/name" qualified identifier, in which case
+// the lookup is dispatched to the named rig.
 // State is derived from beads assignee field:
 // - If an issue is assigned to this polecat and is open/in_progress: StateWorking
 // - If an issue is assigned but closed: StateDone
 // - If no issue assigned: StateIdle
 func (m *Manager) Get(name string) (*Polecat, error) {
-	if !m.exists(name) {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !target.exists(bare) {
 		return nil, ErrPolecatNotFound
 	}
 
-	return m.loadFromBeads(name)
+	return target.loadFromBeads(bare)
 }
 
-// SetState updates a polecat's state.
+// SetState updates a polecat's state. name may be a bare name or a
+// "rig/name" qualified identifier.
 // In the beads model, state is derived from issue status:
 // - StateWorking/StateActive: issue status set to in_progress
 // - StateDone/StateIdle: assignee cleared from issue
 // - StateStuck: issue status set to blocked (if supported)
 // If beads is not available, this is a no-op.
 func (m *Manager) SetState(name string, state State) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.SetState(bare, state)
+	}
+	name = bare
+
 	if !m.exists(name) {
 		return ErrPolecatNotFound
 	}
@@ -307,18 +405,31 @@ func (m *Manager) SetState(name string, state State) error {
 			}
 		}
 	case StateStuck:
-		// Mark issue as blocked if supported, otherwise just note in issue
+		// Mark the issue blocked so the scheduler and status views surface
+		// the stall instead of treating it as quietly in_progress.
 		if issue != nil {
-			// For now, just keep the assignment - the issue's blocked_by would indicate stuck
-			// We could add a status="blocked" here if beads supports it
+			status := "blocked"
+			if err := m.beads.Update(issue.ID, beads.UpdateOptions{Status: &status}); err != nil {
+				return fmt.Errorf("marking issue blocked: %w", err)
+			}
 		}
 	}
 
 	return nil
 }
 
-// AssignIssue assigns an issue to a polecat by setting the issue's assignee in beads.
+// AssignIssue assigns an issue to a polecat by setting the issue's assignee
+// in beads. name may be a bare name or a "rig/name" qualified identifier.
 func (m *Manager) AssignIssue(name, issue string) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.AssignIssue(bare, issue)
+	}
+	name = bare
+
 	if !m.exists(name) {
 		return ErrPolecatNotFound
 	}
@@ -336,11 +447,21 @@ func (m *Manager) AssignIssue(name, issue string) error {
 	return nil
 }
 
-// ClearIssue removes the issue assignment from a polecat.
+// ClearIssue removes the issue assignment from a polecat. name may be a
+// bare name or a "rig/name" qualified identifier.
 // In the ephemeral model, this transitions to Done state for cleanup.
 // This clears the assignee from the currently assigned issue in beads.
 // If beads is not available, this is a no-op.
 func (m *Manager) ClearIssue(name string) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.ClearIssue(bare)
+	}
+	name = bare
+
 	if !m.exists(name) {
 		return ErrPolecatNotFound
 	}
@@ -369,10 +490,20 @@ func (m *Manager) ClearIssue(name string) error {
 	return nil
 }
 
-// Wake transitions a polecat from idle to active.
+// Wake transitions a polecat from idle to active. name may be a bare name
+// or a "rig/name" qualified identifier.
 // Deprecated: In the ephemeral model, polecats start in working state.
 // This method is kept for backward compatibility with existing polecats.
 func (m *Manager) Wake(name string) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Wake(bare)
+	}
+	name = bare
+
 	polecat, err := m.Get(name)
 	if err != nil {
 		return err
@@ -386,10 +517,20 @@ func (m *Manager) Wake(name string) error {
 	return m.SetState(name, StateWorking)
 }
 
-// Sleep transitions a polecat from active to idle.
+// Sleep transitions a polecat from active to idle. name may be a bare name
+// or a "rig/name" qualified identifier.
 // Deprecated: In the ephemeral model, polecats are deleted when done.
 // This method is kept for backward compatibility.
 func (m *Manager) Sleep(name string) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Sleep(bare)
+	}
+	name = bare
+
 	polecat, err := m.Get(name)
 	if err != nil {
 		return err
@@ -403,9 +544,19 @@ func (m *Manager) Sleep(name string) error {
 	return m.SetState(name, StateDone)
 }
 
-// Finish transitions a polecat from working/done/stuck to idle and clears the issue.
+// Finish transitions a polecat from working/done/stuck to idle and clears
+// the issue. name may be a bare name or a "rig/name" qualified identifier.
 // This clears the assignee from any assigned issue.
 func (m *Manager) Finish(name string) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Finish(bare)
+	}
+	name = bare
+
 	polecat, err := m.Get(name)
 	if err != nil {
 		return err
@@ -423,9 +574,19 @@ func (m *Manager) Finish(name string) error {
 	return m.ClearIssue(name)
 }
 
-// Reset forces a polecat to idle state regardless of current state.
+// Reset forces a polecat to idle state regardless of current state. name
+// may be a bare name or a "rig/name" qualified identifier.
 // This clears the assignee from any assigned issue.
 func (m *Manager) Reset(name string) error {
+	target, bare, err := m.managerForName(name)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Reset(bare)
+	}
+	name = bare
+
 	if !m.exists(name) {
 		return ErrPolecatNotFound
 	}
@@ -434,6 +595,86 @@ func (m *Manager) Reset(name string) error {
 	return m.ClearIssue(name)
 }
 
+// Pick renders an interactive tmux display-menu of this rig's polecats,
+// grouped by state, and lets the user finish or reset one directly or just
+// select a name for the caller to act on (e.g. attach). The ctx parameter is
+// reserved for future cancellation support; Show currently blocks on the
+// tmux menu unconditionally.
+func (m *Manager) Pick(ctx context.Context) (string, error) {
+	polecats, err := m.List()
+	if err != nil {
+		return "", fmt.Errorf("listing polecats: %w", err)
+	}
+	if len(polecats) == 0 {
+		return "", fmt.Errorf("no polecats in rig %s", m.rig.Name)
+	}
+
+	var items []picker.Item
+	for _, p := range polecats {
+		state := picker.StateIdle
+		switch p.State {
+		case StateWorking, StateActive:
+			state = picker.StateWorking
+		case StateStuck:
+			state = picker.StateStuck
+		case StateDone:
+			state = picker.StateDone
+		}
+		items = append(items, picker.Item{Name: p.Name, State: state, Issue: p.Issue})
+	}
+
+	res, err := picker.Show(fmt.Sprintf("Polecats: %s", m.rig.Name), items)
+	if err != nil {
+		return "", err
+	}
+
+	switch res.Action {
+	case picker.ActionFinish:
+		return res.Name, m.Finish(res.Name)
+	case picker.ActionReset:
+		return res.Name, m.Reset(res.Name)
+	default:
+		return res.Name, nil
+	}
+}
+
+// ReconcileOrphanedHooks scans for polecats whose tmux session has died
+// without running its lifecycle hooks (e.g. the daemon was down when the
+// session closed, or the hooks were never registered for an older session).
+// For each polecat with no running session and no uncommitted changes, it
+// performs the same cleanup the session-closed hook would have: clearing the
+// beads assignee and removing the worktree. It's meant to be called once on
+// daemon startup to heal any stale state left behind.
+func (m *Manager) ReconcileOrphanedHooks(sessMgr *session.Manager) ([]string, error) {
+	polecats, err := m.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing polecats: %w", err)
+	}
+
+	var cleaned []string
+	for _, p := range polecats {
+		running, err := sessMgr.IsRunning(p.Name)
+		if err != nil || running {
+			continue
+		}
+
+		polecatGit := git.NewGit(p.ClonePath)
+		hasChanges, err := polecatGit.HasUncommittedChanges()
+		if err != nil || hasChanges {
+			// Leave it in place for the user to inspect/clean up manually.
+			continue
+		}
+
+		_ = m.ClearIssue(p.Name)
+		if err := m.Remove(p.Name, false); err != nil {
+			continue
+		}
+		cleaned = append(cleaned, p.Name)
+	}
+
+	return cleaned, nil
+}
+
 // loadFromBeads derives polecat state from beads assignee field.
 // State is derived as follows:
 // - If an issue is assigned to this polecat and is open/in_progress: StateWorking
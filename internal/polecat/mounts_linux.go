@@ -0,0 +1,83 @@
+//go:build linux
+
+package polecat
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// unmountUnder unmounts every mount point rooted under dir (deepest first),
+// so tooling that bind-mounts into a long-lived polecat's worktree - build
+// caches, sandboxes, debuggers - doesn't leave a busy mount that a plain
+// os.RemoveAll can't clear.
+func unmountUnder(dir string) error {
+	mounts, err := mountPointsUnder(dir)
+	if err != nil {
+		return err
+	}
+
+	// Deepest first: a child mount has to come off before its parent
+	// directory can be removed.
+	sort.Slice(mounts, func(i, j int) bool {
+		return len(mounts[i]) > len(mounts[j])
+	})
+
+	for _, mp := range mounts {
+		if err := unmountWithRetry(mp); err != nil {
+			return fmt.Errorf("unmounting %s: %w", mp, err)
+		}
+	}
+	return nil
+}
+
+// mountPointsUnder parses /proc/self/mountinfo for mount points equal to or
+// rooted under dir. See proc(5) for the mountinfo field layout; the mount
+// point is always the 5th whitespace-separated field.
+func mountPointsUnder(dir string) ([]string, error) {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, fmt.Errorf("reading mountinfo: %w", err)
+	}
+	defer f.Close()
+
+	prefix := strings.TrimRight(dir, "/") + "/"
+	var points []string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		mountPoint := fields[4]
+		if mountPoint == dir || strings.HasPrefix(mountPoint, prefix) {
+			points = append(points, mountPoint)
+		}
+	}
+	return points, scanner.Err()
+}
+
+// unmountWithRetry unmounts mountPoint, retrying on EBUSY since a mount can
+// stay briefly busy while another process finishes using it.
+func unmountWithRetry(mountPoint string) error {
+	const maxAttempts = 5
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err := syscall.Unmount(mountPoint, 0)
+		if err == nil {
+			return nil
+		}
+		if err != syscall.EBUSY {
+			return err
+		}
+		lastErr = err
+		time.Sleep(100 * time.Millisecond)
+	}
+	return lastErr
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package polecat
+
+// unmountUnder is a no-op on non-Linux platforms: the bind-mount tooling
+// Recreate guards against (build caches, sandboxes) only bind-mounts into
+// polecat worktrees on Linux today.
+func unmountUnder(dir string) error {
+	return nil
+}
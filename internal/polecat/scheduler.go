@@ -0,0 +1,212 @@
+package polecat
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// defaultMaxConcurrent is used when a rig has no Scheduler config.
+const defaultMaxConcurrent = 3
+
+// SchedulerOptions configures a Scheduler run.
+type SchedulerOptions struct {
+	// PollInterval is the delay between beads queries while work is flowing.
+	// Exponential backoff (capped at MaxBackoff) is applied on top of this
+	// whenever a tick finds nothing ready to dispatch.
+	PollInterval time.Duration
+
+	// MaxBackoff caps the exponential backoff delay.
+	MaxBackoff time.Duration
+}
+
+// DefaultSchedulerOptions returns sensible polling defaults.
+func DefaultSchedulerOptions() SchedulerOptions {
+	return SchedulerOptions{
+		PollInterval: 10 * time.Second,
+		MaxBackoff:   5 * time.Minute,
+	}
+}
+
+// Scheduler periodically queries beads for unassigned, ready-to-work issues
+// and dispatches them to idle polecats via AssignIssue + session.Manager.Start.
+// This closes the loop between the beads issue tracker and the polecat pool
+// so `gt rig start` keeps polecats fed with work automatically, instead of a
+// human calling AllocateName + Add + AssignIssue + session Start per ticket.
+type Scheduler struct {
+	mgr           *Manager
+	sessMgr       *session.Manager
+	opts          SchedulerOptions
+	maxConcurrent int
+}
+
+// NewScheduler creates a Scheduler for a rig's polecat pool. The per-rig
+// concurrency cap comes from the rig's Scheduler config (.gastown/config.json),
+// falling back to defaultMaxConcurrent when unset.
+func NewScheduler(mgr *Manager, sessMgr *session.Manager, opts SchedulerOptions) *Scheduler {
+	maxConcurrent := defaultMaxConcurrent
+
+	rigConfigPath := filepath.Join(mgr.rig.Path, ".gastown", "config.json")
+	if rigConfig, err := config.LoadRigConfig(rigConfigPath); err == nil &&
+		rigConfig.Scheduler != nil && rigConfig.Scheduler.MaxConcurrent > 0 {
+		maxConcurrent = rigConfig.Scheduler.MaxConcurrent
+	}
+
+	return &Scheduler{mgr: mgr, sessMgr: sessMgr, opts: opts, maxConcurrent: maxConcurrent}
+}
+
+// Run dispatches ready issues to idle polecats until ctx is cancelled,
+// backing off exponentially between ticks that find no work.
+func (s *Scheduler) Run(ctx context.Context) error {
+	backoff := s.opts.PollInterval
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		dispatched, err := s.tick()
+		if err != nil {
+			fmt.Printf("Warning: scheduler tick failed: %v\n", err)
+		}
+
+		if dispatched == 0 {
+			backoff *= 2
+			if backoff > s.opts.MaxBackoff {
+				backoff = s.opts.MaxBackoff
+			}
+		} else {
+			backoff = s.opts.PollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// tick runs a single scheduling pass: find idle polecats within the
+// concurrency cap, pull ready-to-work issues from beads, dispatch one to
+// each idle polecat, and mark any working polecat whose issue became
+// blocked as StateStuck. It returns how many issues were dispatched.
+func (s *Scheduler) tick() (int, error) {
+	polecats, err := s.mgr.List()
+	if err != nil {
+		return 0, fmt.Errorf("listing polecats: %w", err)
+	}
+
+	s.markBlocked(polecats)
+
+	active := 0
+	var idle []*Polecat
+	for _, p := range polecats {
+		switch p.State {
+		case StateWorking, StateActive:
+			active++
+		case StateIdle:
+			idle = append(idle, p)
+		}
+	}
+
+	room := s.maxConcurrent - active
+	if room <= 0 || len(idle) == 0 {
+		return 0, nil
+	}
+	if room < len(idle) {
+		idle = idle[:room]
+	}
+
+	issues, err := s.readyIssues()
+	if err != nil {
+		return 0, fmt.Errorf("querying ready issues: %w", err)
+	}
+
+	dispatched := 0
+	for i, p := range idle {
+		if i >= len(issues) {
+			break
+		}
+		issue := issues[i]
+
+		if err := s.mgr.AssignIssue(p.Name, issue.ID); err != nil {
+			fmt.Printf("Warning: assigning %s to %s: %v\n", issue.ID, p.Name, err)
+			continue
+		}
+
+		if running, _ := s.sessMgr.IsRunning(p.Name); !running {
+			if err := s.sessMgr.Start(p.Name, session.StartOptions{Issue: issue.ID}); err != nil {
+				fmt.Printf("Warning: starting session for %s: %v\n", p.Name, err)
+				continue
+			}
+		}
+
+		dispatched++
+	}
+
+	return dispatched, nil
+}
+
+// readyIssues returns unassigned open issues whose dependencies (blocked_by)
+// are all closed.
+func (s *Scheduler) readyIssues() ([]*beads.Issue, error) {
+	issues, err := s.mgr.beads.List(beads.ListOptions{
+		Status:   "open",
+		Priority: -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ready []*beads.Issue
+	for _, issue := range issues {
+		if issue.Assignee != "" {
+			continue
+		}
+		if s.allDepsClosed(issue) {
+			ready = append(ready, issue)
+		}
+	}
+	return ready, nil
+}
+
+// allDepsClosed reports whether every issue in DependsOn is closed.
+func (s *Scheduler) allDepsClosed(issue *beads.Issue) bool {
+	for _, depID := range issue.DependsOn {
+		dep, err := s.mgr.beads.Show(depID)
+		if err != nil || dep.Status != "closed" {
+			return false
+		}
+	}
+	return true
+}
+
+// markBlocked transitions working polecats whose assigned issue has itself
+// become blocked to StateStuck, so the scheduler (and `gt mol status`, the
+// picker, etc.) surface the stall instead of waiting silently.
+func (s *Scheduler) markBlocked(polecats []*Polecat) {
+	for _, p := range polecats {
+		if p.State != StateWorking && p.State != StateActive {
+			continue
+		}
+		if p.Issue == "" {
+			continue
+		}
+
+		issue, err := s.mgr.beads.Show(p.Issue)
+		if err != nil {
+			continue
+		}
+		if issue.Status == "blocked" {
+			_ = s.mgr.SetState(p.Name, StateStuck)
+		}
+	}
+}
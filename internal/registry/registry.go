@@ -0,0 +1,103 @@
+// Package registry enumerates rigs across a Gas Town workspace so that
+// commands can operate across every rig at once (e.g. listing all running
+// sessions, or finding whichever polecat owns a given issue) without the
+// caller needing to know which rig to look in ahead of time.
+package registry
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Registry enumerates all rigs configured under a town root.
+type Registry struct {
+	townRoot   string
+	rigsConfig *config.RigsConfig
+	rigMgr     *rig.Manager
+}
+
+// New creates a Registry rooted at townRoot, loading the mayor's rigs.json.
+func New(townRoot string) (*Registry, error) {
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading rigs config: %w", err)
+	}
+
+	g := git.NewGit(townRoot)
+	return &Registry{
+		townRoot:   townRoot,
+		rigsConfig: rigsConfig,
+		rigMgr:     rig.NewManager(townRoot, rigsConfig, g),
+	}, nil
+}
+
+// Rigs returns every rig registered in the town. Stale entries in rigs.json
+// (e.g. a rig whose directory was removed) are skipped rather than erroring.
+func (r *Registry) Rigs() []*rig.Rig {
+	var rigs []*rig.Rig
+	for name := range r.rigsConfig.Rigs {
+		rg, err := r.rigMgr.GetRig(name)
+		if err != nil {
+			continue
+		}
+		rigs = append(rigs, rg)
+	}
+	return rigs
+}
+
+// ListSessions returns running sessions across every rig in the town. This
+// is what session.Manager.List can't do on its own, since it only knows
+// about its own rig's "gt-<rig>-" session prefix.
+func (r *Registry) ListSessions() ([]session.Info, error) {
+	t := tmux.NewTmux()
+
+	var all []session.Info
+	for _, rg := range r.Rigs() {
+		sessMgr := session.NewManager(t, rg)
+		infos, err := sessMgr.List()
+		if err != nil {
+			continue
+		}
+		all = append(all, infos...)
+	}
+	return all, nil
+}
+
+// ListPolecats returns every polecat across every rig in the town.
+func (r *Registry) ListPolecats() ([]*polecat.Polecat, error) {
+	var all []*polecat.Polecat
+	for _, rg := range r.Rigs() {
+		polecatMgr := polecat.NewManager(rg, git.NewGit(rg.Path))
+		polecats, err := polecatMgr.List()
+		if err != nil {
+			continue
+		}
+		all = append(all, polecats...)
+	}
+	return all, nil
+}
+
+// FindByIssue returns the rig-qualified name ("rig/polecat") of whichever
+// polecat currently has issueID assigned, searching across all rigs.
+func (r *Registry) FindByIssue(issueID string) (string, error) {
+	polecats, err := r.ListPolecats()
+	if err != nil {
+		return "", err
+	}
+
+	for _, p := range polecats {
+		if p.Issue == issueID {
+			return fmt.Sprintf("%s/%s", p.Rig, p.Name), nil
+		}
+	}
+
+	return "", fmt.Errorf("no polecat found working on %s", issueID)
+}
@@ -2,6 +2,7 @@
 package session
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -10,6 +11,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/picker"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/tmux"
 )
@@ -45,6 +49,12 @@ type StartOptions struct {
 
 	// Command overrides the default "claude" command.
 	Command string
+
+	// NoLifecycleHooks skips registering the tmux session-closed/client-detached
+	// hooks that normally drive automatic polecat cleanup. Set this for sessions
+	// that aren't backed by a polecat worktree, or when the caller wants to
+	// manage lifecycle itself.
+	NoLifecycleHooks bool
 }
 
 // Info contains information about a running session.
@@ -71,9 +81,73 @@ type Info struct {
 	Windows int `json:"windows,omitempty"`
 }
 
+// splitQualifiedName splits a fully-qualified "rig/polecat" identifier into
+// its rig and bare name parts. If name has no slash, rigName is "".
+func splitQualifiedName(name string) (rigName, bare string) {
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		return name[:idx], name[idx+1:]
+	}
+	return "", name
+}
+
+// managerForName resolves the Manager that should handle a (possibly
+// rig-qualified) polecat name, constructing one for the named rig if it
+// isn't this manager's own. This lets callers address any rig's sessions
+// (e.g. "attach to whichever polecat is working on BEAD-123") without
+// building a new Manager themselves.
+func (m *Manager) managerForName(name string) (*Manager, string, error) {
+	rigName, bare := splitQualifiedName(name)
+	if rigName == "" || rigName == m.rig.Name {
+		return m, bare, nil
+	}
+
+	townRoot := filepath.Dir(m.rig.Path)
+	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("loading rigs config: %w", err)
+	}
+
+	rigMgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	r, err := rigMgr.GetRig(rigName)
+	if err != nil {
+		return nil, "", fmt.Errorf("rig '%s' not found", rigName)
+	}
+
+	return NewManager(m.tmux, r), bare, nil
+}
+
+// isSafeName reports whether s is safe to interpolate into a shell command
+// string (e.g. registerLifecycleHooks' run-shell argument) or a tmux session
+// name: letters, digits, dash, underscore, and dot only. This excludes every
+// shell metacharacter, in particular the single quote a run-shell '...'
+// argument is wrapped in, so a validated name can't break out of it.
+func isSafeName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-' || r == '_' || r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // sessionName generates the tmux session name for a polecat.
 func (m *Manager) sessionName(polecat string) string {
-	return fmt.Sprintf("gt-%s-%s", m.rig.Name, polecat)
+	return SessionName(m.rig.Name, polecat)
+}
+
+// SessionName generates the tmux session name for a polecat in rigName.
+// Exported so callers outside a Manager (e.g. the session-closed hook
+// handler, which only has the rig and polecat names) can name the same
+// session without duplicating the format.
+func SessionName(rigName, polecat string) string {
+	return fmt.Sprintf("gt-%s-%s", rigName, polecat)
 }
 
 // polecatDir returns the working directory for a polecat.
@@ -92,8 +166,23 @@ func (m *Manager) hasPolecat(polecat string) bool {
 	return info.IsDir()
 }
 
-// Start creates and starts a new session for a polecat.
+// Start creates and starts a new session for a polecat. polecat may be a
+// bare name (resolved in this manager's rig) or a "rig/name" qualified
+// identifier, in which case the session is started in the named rig.
 func (m *Manager) Start(polecat string, opts StartOptions) error {
+	target, bare, err := m.managerForName(polecat)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Start(bare, opts)
+	}
+	polecat = bare
+
+	if !isSafeName(polecat) {
+		return fmt.Errorf("invalid polecat name %q", polecat)
+	}
+
 	if !m.hasPolecat(polecat) {
 		return fmt.Errorf("%w: %s", ErrPolecatNotFound, polecat)
 	}
@@ -153,12 +242,65 @@ func (m *Manager) Start(polecat string, opts StartOptions) error {
 		_ = m.Inject(polecat, prompt) // Non-fatal error
 	}
 
+	// Register lifecycle hooks so the polecat cleans itself up if the user
+	// kills the tmux session from outside gt (closing the window, detaching
+	// and letting it die, etc.) instead of going through Stop/Remove.
+	if !opts.NoLifecycleHooks {
+		if err := m.registerLifecycleHooks(sessionID, polecat); err != nil {
+			// Non-fatal - the session still works, it just won't self-heal.
+			fmt.Printf("Warning: could not register lifecycle hooks: %v\n", err)
+		}
+	}
+
+	return nil
+}
+
+// registerLifecycleHooks wires tmux session-closed and client-detached hooks
+// so that killing or detaching from a polecat session outside of `gt` still
+// triggers cleanup. Both hooks shell out to `gt internal polecat-cleanup`,
+// which releases the name pool slot, clears the beads assignee, and removes
+// the worktree if it has no uncommitted changes.
+//
+// polecat and m.rig.Name are interpolated into the hook's run-shell '...'
+// string, so both must be restricted to isSafeName's charset first - Start
+// already validates polecat, but rig.Name isn't attacker-controlled the same
+// way, so this is a defense-in-depth check rather than the primary guard.
+func (m *Manager) registerLifecycleHooks(sessionID, polecat string) error {
+	if !isSafeName(polecat) || !isSafeName(m.rig.Name) {
+		return fmt.Errorf("invalid polecat or rig name for lifecycle hooks: %s/%s", m.rig.Name, polecat)
+	}
+
+	gtPath, err := os.Executable()
+	if err != nil {
+		gtPath = "gt" // fall back to PATH lookup
+	}
+
+	for _, event := range []string{"session-closed", "client-detached"} {
+		cleanupCmd := fmt.Sprintf("%s internal polecat-cleanup --rig %s --polecat %s --event %s",
+			gtPath, m.rig.Name, polecat, event)
+		hookCmd := exec.Command("tmux", "set-hook", "-t", sessionID, event,
+			fmt.Sprintf("run-shell '%s'", cleanupCmd))
+		if out, err := hookCmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("setting %s hook: %w (%s)", event, err, strings.TrimSpace(string(out)))
+		}
+	}
+
 	return nil
 }
 
-// Stop terminates a polecat session.
+// Stop terminates a polecat session. polecat may be a bare name or a
+// "rig/name" qualified identifier.
 // If force is true, skips graceful shutdown and kills immediately.
 func (m *Manager) Stop(polecat string, force bool) error {
+	target, bare, err := m.managerForName(polecat)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Stop(bare, force)
+	}
+	polecat = bare
+
 	sessionID := m.sessionName(polecat)
 
 	// Check if session exists
@@ -201,14 +343,33 @@ func (m *Manager) syncBeads(workDir string) error {
 	return cmd.Run()
 }
 
-// IsRunning checks if a polecat session is active.
+// IsRunning checks if a polecat session is active. polecat may be a bare
+// name or a "rig/name" qualified identifier.
 func (m *Manager) IsRunning(polecat string) (bool, error) {
-	sessionID := m.sessionName(polecat)
+	target, bare, err := m.managerForName(polecat)
+	if err != nil {
+		return false, err
+	}
+	if target != m {
+		return target.IsRunning(bare)
+	}
+
+	sessionID := m.sessionName(bare)
 	return m.tmux.HasSession(sessionID)
 }
 
-// Status returns detailed status for a polecat session.
+// Status returns detailed status for a polecat session. polecat may be a
+// bare name or a "rig/name" qualified identifier.
 func (m *Manager) Status(polecat string) (*Info, error) {
+	target, bare, err := m.managerForName(polecat)
+	if err != nil {
+		return nil, err
+	}
+	if target != m {
+		return target.Status(bare)
+	}
+	polecat = bare
+
 	sessionID := m.sessionName(polecat)
 
 	running, err := m.tmux.HasSession(sessionID)
@@ -284,8 +445,18 @@ func (m *Manager) List() ([]Info, error) {
 	return infos, nil
 }
 
-// Attach attaches to a polecat session.
+// Attach attaches to a polecat session. polecat may be a bare name or a
+// "rig/name" qualified identifier.
 func (m *Manager) Attach(polecat string) error {
+	target, bare, err := m.managerForName(polecat)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Attach(bare)
+	}
+	polecat = bare
+
 	sessionID := m.sessionName(polecat)
 
 	running, err := m.tmux.HasSession(sessionID)
@@ -299,8 +470,18 @@ func (m *Manager) Attach(polecat string) error {
 	return m.tmux.AttachSession(sessionID)
 }
 
-// Capture returns the recent output from a polecat session.
+// Capture returns the recent output from a polecat session. polecat may be
+// a bare name or a "rig/name" qualified identifier.
 func (m *Manager) Capture(polecat string, lines int) (string, error) {
+	target, bare, err := m.managerForName(polecat)
+	if err != nil {
+		return "", err
+	}
+	if target != m {
+		return target.Capture(bare, lines)
+	}
+	polecat = bare
+
 	sessionID := m.sessionName(polecat)
 
 	running, err := m.tmux.HasSession(sessionID)
@@ -314,9 +495,19 @@ func (m *Manager) Capture(polecat string, lines int) (string, error) {
 	return m.tmux.CapturePane(sessionID, lines)
 }
 
-// Inject sends a message to a polecat session.
+// Inject sends a message to a polecat session. polecat may be a bare name or
+// a "rig/name" qualified identifier.
 // Uses a longer debounce delay for large messages to ensure paste completes.
 func (m *Manager) Inject(polecat, message string) error {
+	target, bare, err := m.managerForName(polecat)
+	if err != nil {
+		return err
+	}
+	if target != m {
+		return target.Inject(bare, message)
+	}
+	polecat = bare
+
 	sessionID := m.sessionName(polecat)
 
 	running, err := m.tmux.HasSession(sessionID)
@@ -338,6 +529,42 @@ func (m *Manager) Inject(polecat, message string) error {
 	return m.tmux.SendKeysDebounced(sessionID, message, debounceMs)
 }
 
+// Pick renders an interactive tmux display-menu of this rig's running
+// sessions, grouped by state, and lets the user attach to or stop one
+// directly. The ctx parameter is reserved for future cancellation support.
+func (m *Manager) Pick(ctx context.Context) (string, error) {
+	infos, err := m.List()
+	if err != nil {
+		return "", fmt.Errorf("listing sessions: %w", err)
+	}
+	if len(infos) == 0 {
+		return "", fmt.Errorf("no running sessions in rig %s", m.rig.Name)
+	}
+
+	var items []picker.Item
+	for _, info := range infos {
+		items = append(items, picker.Item{
+			Name:     info.Polecat,
+			State:    picker.StateWorking,
+			Attached: info.Attached,
+		})
+	}
+
+	res, err := picker.Show(fmt.Sprintf("Sessions: %s", m.rig.Name), items)
+	if err != nil {
+		return "", err
+	}
+
+	switch res.Action {
+	case picker.ActionAttach:
+		return res.Name, m.Attach(res.Name)
+	case picker.ActionStop:
+		return res.Name, m.Stop(res.Name, false)
+	default:
+		return res.Name, nil
+	}
+}
+
 // StopAll terminates all sessions for this rig.
 func (m *Manager) StopAll(force bool) error {
 	infos, err := m.List()
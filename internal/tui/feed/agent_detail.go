@@ -0,0 +1,94 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// agentDetailView shows a single agent's info plus every event it was the
+// actor for, drilled into from the tree via m.keys.Inspect. It closes on
+// Esc, returning control to whatever was the base view before it (always
+// the feed today, since only the feed currently offers a drill-down key).
+type agentDetailView struct {
+	agent  *Agent
+	events []Event
+	vp     viewport.Model
+}
+
+func newAgentDetailView(agent *Agent, events []Event) *agentDetailView {
+	v := &agentDetailView{agent: agent, events: events, vp: viewport.New(0, 0)}
+	v.vp.SetContent(v.renderBody())
+	return v
+}
+
+func (v *agentDetailView) Init() tea.Cmd { return nil }
+
+func (v *agentDetailView) Update(msg tea.Msg) (subView, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyEsc {
+			return nil, nil
+		}
+	}
+	var cmd tea.Cmd
+	v.vp, cmd = v.vp.Update(msg)
+	return v, cmd
+}
+
+func (v *agentDetailView) SetSize(w, h int) {
+	v.vp.Width = w
+	v.vp.Height = h - 2 // header + status line
+}
+
+func (v *agentDetailView) View() string {
+	header := fmt.Sprintf("agent: %s  role: %s  rig: %s  status: %s",
+		v.agent.Name, v.agent.Role, v.agent.Rig, v.agent.Status)
+	footer := "esc: back to feed"
+	return strings.Join([]string{header, v.vp.View(), footer}, "\n")
+}
+
+func (v *agentDetailView) renderBody() string {
+	if len(v.events) == 0 {
+		return "(no events for this agent yet)"
+	}
+	var b strings.Builder
+	for _, e := range v.events {
+		fmt.Fprintf(&b, "%s  %-8s %s\n", e.Time.Format("15:04:05"), e.Type, e.Message)
+	}
+	return b.String()
+}
+
+// mostRecentAgent returns the agent that owns the feed's most recent
+// event, or nil if the feed is empty - the stand-in for an explicit tree
+// cursor/selection, which the tree view doesn't have yet.
+func (m *Model) mostRecentAgent() *Agent {
+	for i := len(m.events) - 1; i >= 0; i-- {
+		e := m.events[i]
+		if e.Actor == "" {
+			continue
+		}
+		rig, ok := m.rigs[e.Rig]
+		if !ok {
+			continue
+		}
+		if agent, ok := rig.Agents[e.Actor]; ok {
+			return agent
+		}
+	}
+	return nil
+}
+
+// agentEvents returns every recorded event whose actor is agent, oldest
+// first.
+func (m *Model) agentEvents(agent *Agent) []Event {
+	var out []Event
+	for _, e := range m.events {
+		if e.Actor == agent.ID {
+			out = append(out, e)
+		}
+	}
+	return out
+}
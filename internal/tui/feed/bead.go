@@ -0,0 +1,70 @@
+package feed
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// BeadFetcher loads the full detail behind a bead ID for the inspector
+// panel. Kept as an interface (rather than a direct beads.Beads
+// dependency) so the feed package doesn't need to know how the detail is
+// sourced - the real implementation wired in by SetBeadFetcher talks to
+// beads.Beads, but tests or alternate front-ends can supply their own.
+type BeadFetcher interface {
+	FetchBead(id string) (*BeadDetail, error)
+}
+
+// BeadDetail is everything the inspector panel shows for one bead: enough
+// to go from "I saw a one-line event" to "I understand what this bead is
+// and how it got here" without leaving the TUI.
+type BeadDetail struct {
+	ID            string
+	Title         string
+	Status        string
+	MoleculeGraph string // rendered molecule DAG this bead belongs to, if any
+	Transitions   []BeadTransition
+	Crew          []string
+	Raw           string // raw issue payload, for the rare case the summary above isn't enough
+}
+
+// BeadTransition is one state change in a bead's history.
+type BeadTransition struct {
+	Time  time.Time
+	From  string
+	To    string
+	Actor string
+}
+
+// beadLoadedMsg carries the result of an async FetchBead call back into
+// Update. id lets the receiving subView ignore stale loads - e.g. if the
+// user closed the inspector and opened a different bead before the first
+// fetch returned.
+type beadLoadedMsg struct {
+	id     string
+	detail *BeadDetail
+	err    error
+}
+
+// fetchBeadCmd returns a command that loads id via m.beadFetcher off the
+// render loop, so a slow lookup (network, beads DB) doesn't freeze the
+// TUI - the inspector opens immediately in a loading state and repaints
+// once beadLoadedMsg arrives.
+func (m *Model) fetchBeadCmd(id string) tea.Cmd {
+	fetcher := m.beadFetcher
+	return func() tea.Msg {
+		if fetcher == nil {
+			return beadLoadedMsg{id: id, err: fmt.Errorf("no bead fetcher configured")}
+		}
+		detail, err := fetcher.FetchBead(id)
+		return beadLoadedMsg{id: id, detail: detail, err: err}
+	}
+}
+
+// SetBeadFetcher wires the source the bead inspector panel loads from.
+// Optional: with none set, opening the inspector shows a "no bead fetcher
+// configured" error rather than panicking.
+func (m *Model) SetBeadFetcher(f BeadFetcher) {
+	m.beadFetcher = f
+}
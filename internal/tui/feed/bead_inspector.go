@@ -0,0 +1,122 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// beadInspectorView is the drill-down opened by m.keys.Open: the full bead
+// behind an event or agent's last event - molecule graph, recent state
+// transitions, crew, and raw payload. Opens immediately in a loading
+// state; fetching happens off the render loop via fetchBeadCmd and
+// repaints the view when beadLoadedMsg arrives.
+type beadInspectorView struct {
+	id      string
+	vp      viewport.Model
+	loading bool
+	err     error
+	detail  *BeadDetail
+}
+
+func newBeadInspectorView(id string) *beadInspectorView {
+	v := &beadInspectorView{id: id, vp: viewport.New(0, 0), loading: true}
+	v.vp.SetContent(fmt.Sprintf("loading bead %s...", id))
+	return v
+}
+
+func (v *beadInspectorView) Init() tea.Cmd { return nil }
+
+func (v *beadInspectorView) Update(msg tea.Msg) (subView, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyEsc {
+			return nil, nil
+		}
+
+	case beadLoadedMsg:
+		if msg.id != v.id {
+			return v, nil
+		}
+		v.loading = false
+		v.err = msg.err
+		v.detail = msg.detail
+		v.vp.SetContent(v.renderBody())
+		return v, nil
+	}
+
+	var cmd tea.Cmd
+	v.vp, cmd = v.vp.Update(msg)
+	return v, cmd
+}
+
+func (v *beadInspectorView) SetSize(w, h int) {
+	v.vp.Width = w
+	v.vp.Height = h - 2
+}
+
+func (v *beadInspectorView) View() string {
+	header := fmt.Sprintf("bead: %s", v.id)
+	footer := "esc: back"
+	return strings.Join([]string{header, v.vp.View(), footer}, "\n")
+}
+
+func (v *beadInspectorView) renderBody() string {
+	if v.err != nil {
+		return fmt.Sprintf("error loading bead %s: %v", v.id, v.err)
+	}
+	if v.detail == nil {
+		return "loading..."
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\nstatus: %s\n", v.detail.Title, v.detail.Status)
+
+	if v.detail.MoleculeGraph != "" {
+		b.WriteString("\nmolecule graph:\n")
+		b.WriteString(highlightPayload(v.detail.MoleculeGraph))
+		b.WriteString("\n")
+	}
+
+	if len(v.detail.Crew) > 0 {
+		fmt.Fprintf(&b, "\ncrew: %s\n", strings.Join(v.detail.Crew, ", "))
+	}
+
+	if len(v.detail.Transitions) > 0 {
+		b.WriteString("\ntransitions:\n")
+		for _, t := range v.detail.Transitions {
+			fmt.Fprintf(&b, "%s  %s -> %s  (%s)\n", t.Time.Format("15:04:05"), t.From, t.To, t.Actor)
+		}
+	}
+
+	if v.detail.Raw != "" {
+		b.WriteString("\nraw payload:\n")
+		b.WriteString(highlightPayload(v.detail.Raw))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// highlightPayload marks up the key token of each "key: value" /
+// `"key": value` line using the same highlight markers filter.go uses for
+// fuzzy-match hits - a full grammar-aware lexer is more than this
+// inspector needs; marking key tokens is enough for render() to dim or
+// bold them when it turns the markers into real styling.
+func highlightPayload(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		idx := strings.Index(line, ":")
+		if idx <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(strings.Trim(line[:idx], `"`))
+		if key == "" {
+			continue
+		}
+		lines[i] = filterMatchOpen + key + filterMatchClose + line[idx:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,147 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// paletteCommand is one entry in the command palette: a name to match
+// against and fuzzy-filter on, and the action to run against the model
+// when it's chosen.
+type paletteCommand struct {
+	Name string
+	Run  func(m *Model) tea.Cmd
+}
+
+// paletteCommands lists every action the command palette can run. New
+// drill-downs or toggles should be added here rather than given their own
+// dedicated key, once they don't need a single-key shortcut of their own.
+func paletteCommands(m *Model) []paletteCommand {
+	return []paletteCommand{
+		{Name: "focus tree", Run: func(m *Model) tea.Cmd { m.focusedPanel = PanelTree; return nil }},
+		{Name: "focus feed", Run: func(m *Model) tea.Cmd { m.focusedPanel = PanelFeed; return nil }},
+		{Name: "focus timeline", Run: func(m *Model) tea.Cmd { m.focusedPanel = PanelTimeline; return nil }},
+		{Name: "toggle help", Run: func(m *Model) tea.Cmd {
+			m.showHelp = !m.showHelp
+			m.help.ShowAll = m.showHelp
+			return nil
+		}},
+		{Name: "toggle timeline pause", Run: func(m *Model) tea.Cmd {
+			m.timelinePaused = !m.timelinePaused
+			if !m.timelinePaused {
+				m.timelineIdx = len(m.events) - 1
+			}
+			m.updateViewContent()
+			return nil
+		}},
+		{Name: "inspect most recent agent", Run: func(m *Model) tea.Cmd {
+			if agent := m.mostRecentAgent(); agent != nil {
+				m.wm.SetBase(StateAgentDetail, newAgentDetailView(agent, m.agentEvents(agent)))
+			}
+			return nil
+		}},
+		{Name: "open bead inspector", Run: func(m *Model) tea.Cmd { return m.openBead() }},
+		{Name: "clear filter", Run: func(m *Model) tea.Cmd {
+			m.filter = ""
+			m.feedMatchIdx = 0
+			m.updateViewContent()
+			return nil
+		}},
+	}
+}
+
+// commandPaletteView is a modal, fuzzy-filterable list of paletteCommands.
+// Typing narrows the list (same fuzzyMatch used by the feed/tree filter),
+// up/down moves the selection, Enter runs the selected command and
+// closes, Esc closes without running anything.
+type commandPaletteView struct {
+	model    *Model
+	commands []paletteCommand
+	query    string
+	selected int
+}
+
+func newCommandPaletteView(m *Model) *commandPaletteView {
+	return &commandPaletteView{model: m, commands: paletteCommands(m)}
+}
+
+func (v *commandPaletteView) Init() tea.Cmd { return nil }
+
+func (v *commandPaletteView) matches() []paletteCommand {
+	if v.query == "" {
+		return v.commands
+	}
+	var out []paletteCommand
+	for _, c := range v.commands {
+		if matched, _ := fuzzyMatch(v.query, c.Name); matched {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (v *commandPaletteView) Update(msg tea.Msg) (subView, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return v, nil
+	}
+
+	matches := v.matches()
+
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		return nil, nil
+
+	case tea.KeyEnter:
+		if len(matches) == 0 {
+			return nil, nil
+		}
+		if v.selected >= len(matches) {
+			v.selected = len(matches) - 1
+		}
+		cmd := matches[v.selected].Run(v.model)
+		return nil, cmd
+
+	case tea.KeyUp:
+		if v.selected > 0 {
+			v.selected--
+		}
+		return v, nil
+
+	case tea.KeyDown:
+		if v.selected < len(matches)-1 {
+			v.selected++
+		}
+		return v, nil
+
+	case tea.KeyBackspace:
+		if v.query != "" {
+			runes := []rune(v.query)
+			v.query = string(runes[:len(runes)-1])
+			v.selected = 0
+		}
+		return v, nil
+
+	case tea.KeyRunes, tea.KeySpace:
+		v.query += keyMsg.String()
+		v.selected = 0
+		return v, nil
+	}
+
+	return v, nil
+}
+
+func (v *commandPaletteView) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "> %s\n", v.query)
+	for i, c := range v.matches() {
+		cursor := "  "
+		if i == v.selected {
+			cursor = "> "
+		}
+		fmt.Fprintf(&b, "%s%s\n", cursor, c.Name)
+	}
+	return b.String()
+}
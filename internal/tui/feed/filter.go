@@ -0,0 +1,125 @@
+package feed
+
+import "strings"
+
+// fuzzyMatch reports whether every rune of query appears in target in
+// order (not necessarily contiguous) - the same subsequence matching
+// sahilm/fuzzy and similar fuzzy-finders use. Matching is case-insensitive.
+// When matched, it also returns the index of each matched rune in target,
+// for highlighting.
+func fuzzyMatch(query, target string) (matched bool, positions []int) {
+	if query == "" {
+		return false, nil
+	}
+
+	q := []rune(strings.ToLower(query))
+	t := []rune(strings.ToLower(target))
+
+	positions = make([]int, 0, len(q))
+	qi := 0
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] == q[qi] {
+			positions = append(positions, ti)
+			qi++
+		}
+	}
+
+	if qi < len(q) {
+		return false, nil
+	}
+	return true, positions
+}
+
+// highlightMatches wraps each matched rune of s (positions, as returned by
+// fuzzyMatch) with highlight markers, for rendering in a viewport. render()
+// is responsible for turning the markers into the actual ANSI style.
+func highlightMatches(s string, positions []int) string {
+	if len(positions) == 0 {
+		return s
+	}
+
+	runes := []rune(s)
+	marked := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		marked[p] = true
+	}
+
+	var b strings.Builder
+	for i, r := range runes {
+		if marked[i] {
+			b.WriteString(filterMatchOpen)
+			b.WriteRune(r)
+			b.WriteString(filterMatchClose)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// filterMatchOpen/filterMatchClose bracket a fuzzy-matched run of
+// characters in text handed to highlightMatches. render() replaces these
+// markers with the actual highlight style when it builds the final
+// lipgloss-rendered line, keeping the matching logic here independent of
+// any particular style choice.
+const (
+	filterMatchOpen  = "\x00match\x00"
+	filterMatchClose = "\x00/match\x00"
+)
+
+// eventFilterText concatenates the event fields fuzzy search should
+// consider, in priority order (message first, since it's what a user
+// scanning the feed actually reads).
+func eventFilterText(e Event) string {
+	return e.Message + " " + e.Target + " " + e.Actor
+}
+
+// EventMatchesFilter reports whether e matches the current filter query,
+// and - when it does - the highlighted form of its filter text for
+// rendering. An empty filter matches everything (no highlighting).
+func (m *Model) EventMatchesFilter(e Event) (bool, string) {
+	if m.filter == "" {
+		return true, eventFilterText(e)
+	}
+	text := eventFilterText(e)
+	matched, positions := fuzzyMatch(m.filter, text)
+	if !matched {
+		return false, ""
+	}
+	return true, highlightMatches(text, positions)
+}
+
+// agentFilterText concatenates the agent fields fuzzy search should
+// consider.
+func agentFilterText(a *Agent) string {
+	return a.Name + " " + a.Role + " " + a.Rig
+}
+
+// AgentMatchesFilter reports whether a matches the current filter query,
+// and - when it does - the highlighted form of its filter text.
+func (m *Model) AgentMatchesFilter(a *Agent) (bool, string) {
+	if m.filter == "" {
+		return true, agentFilterText(a)
+	}
+	text := agentFilterText(a)
+	matched, positions := fuzzyMatch(m.filter, text)
+	if !matched {
+		return false, ""
+	}
+	return true, highlightMatches(text, positions)
+}
+
+// matchingFeedIndices returns, in feed order, the indices into m.events
+// that currently match the filter - the set n/N cycle through.
+func (m *Model) matchingFeedIndices() []int {
+	if m.filter == "" {
+		return nil
+	}
+	var indices []int
+	for i, e := range m.events {
+		if matched, _ := m.EventMatchesFilter(e); matched {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
@@ -0,0 +1,144 @@
+package feed
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// defaultJournalMaxBytes is the rotation threshold for a journal file -
+// past this size, Append rolls the current file to <path>.1 (overwriting
+// any prior rotation) and starts a fresh one, bounding how much disk a
+// long-running feed TUI accumulates while still keeping one full rotation
+// of history available to ReplayJournal.
+const defaultJournalMaxBytes = 32 * 1024 * 1024
+
+// Journal appends every Event the feed TUI sees to a rotating on-disk JSONL
+// log, so a later run (via SetJournalPath or --replay) can reconstruct
+// historical context instead of only showing events seen since launch.
+type Journal struct {
+	mu       sync.Mutex
+	path     string
+	maxBytes int64
+	f        *os.File
+	size     int64
+}
+
+// NewJournal opens (creating if necessary) path for appending.
+func NewJournal(path string) (*Journal, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("opening journal %s: %w", path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &Journal{path: path, maxBytes: defaultJournalMaxBytes, f: f, size: info.Size()}, nil
+}
+
+// Append writes e as one JSON line, rotating the file first if it has
+// grown past maxBytes.
+func (j *Journal) Append(e Event) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.size >= j.maxBytes {
+		if err := j.rotateLocked(); err != nil {
+			return err
+		}
+	}
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	n, err := j.f.Write(line)
+	if err != nil {
+		return err
+	}
+	j.size += int64(n)
+	return nil
+}
+
+// rotateLocked renames the current journal to <path>.1 (replacing any
+// previous rotation) and opens a fresh file at path. Caller must hold j.mu.
+func (j *Journal) rotateLocked() error {
+	if err := j.f.Close(); err != nil {
+		return err
+	}
+	rotated := j.path + ".1"
+	if err := os.Rename(j.path, rotated); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(j.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	j.f = f
+	j.size = 0
+	return nil
+}
+
+// Close closes the underlying file.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.f.Close()
+}
+
+// ReplayJournal reads every event recorded at path, oldest first: the
+// rotated <path>.1 (if present) followed by the current path, so history
+// survives one rotation. Used both by SetJournalPath on startup and by a
+// standalone --replay <file> post-mortem viewer.
+func ReplayJournal(path string) ([]Event, error) {
+	var events []Event
+
+	for _, p := range []string{path + ".1", path} {
+		es, err := readJournalFile(p)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, es...)
+	}
+
+	return events, nil
+}
+
+// readJournalFile reads one journal file's events, skipping (rather than
+// failing on) any corrupt line - a partially-written last line from a
+// killed process shouldn't cost the user the rest of their history.
+func readJournalFile(path string) ([]Event, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			continue
+		}
+		events = append(events, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return events, nil
+}
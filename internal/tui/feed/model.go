@@ -1,6 +1,8 @@
 package feed
 
 import (
+	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -8,6 +10,7 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
 	"github.com/steveyegge/gastown/internal/beads"
 )
 
@@ -17,18 +20,20 @@ type Panel int
 const (
 	PanelTree Panel = iota
 	PanelFeed
+	PanelTimeline
 )
 
 // Event represents an activity event
 type Event struct {
-	Time     time.Time
-	Type     string // create, update, complete, fail, delete
-	Actor    string // who did it (e.g., "gastown/crew/joe")
-	Target   string // what was affected (e.g., "gt-xyz")
-	Message  string // human-readable description
-	Rig      string // which rig
-	Role     string // actor's role
-	Raw      string // raw line for fallback display
+	Time    time.Time
+	Type    string // create, update, complete, fail, delete
+	Actor   string // who did it (e.g., "gastown/crew/joe")
+	Target  string // what was affected (e.g., "gt-xyz")
+	Message string // human-readable description
+	Rig     string // which rig
+	Role    string // actor's role
+	Raw     string // raw line for fallback display
+	Source  string // name of the EventSource this arrived from
 }
 
 // Agent represents an agent in the tree
@@ -66,17 +71,53 @@ type Model struct {
 	events []Event
 
 	// UI state
-	keys     KeyMap
-	help     help.Model
-	showHelp bool
-	filter   string
-
-	// Event source
-	eventChan <-chan Event
+	keys         KeyMap
+	help         help.Model
+	showHelp     bool
+	filter       string
+	filterMode   bool // true while the user is typing into the filter after '/'
+	feedMatchIdx int  // index into matchingFeedIndices() the user last jumped to
+
+	// Timeline scrubbing (PanelTimeline) - paused freezes the live tail and
+	// lets the user step through history; timelineIdx is the scrubbed
+	// position into events when paused.
+	timelinePaused bool
+	timelineIdx    int
+
+	// Window manager - owns drill-down views (agent/rig/bead detail) and
+	// modals (the command palette) layered on top of the feed itself.
+	wm *WindowManager
+
+	// beadFetcher backs the bead inspector panel (m.keys.Open). Nil until
+	// SetBeadFetcher is called, in which case opening the inspector shows
+	// an error instead of a fetched bead.
+	beadFetcher BeadFetcher
+
+	// zoneMgr tracks where each clickable rig header, agent row, and
+	// event line actually landed on screen once rendered, so handleMouse
+	// can map a click's coordinates back to what was drawn there. View
+	// scans the fully-rendered frame through it every pass; render()
+	// (elsewhere in the package) is what marks each row with a zone ID in
+	// the first place.
+	zoneMgr *zone.Manager
+
+	// Persistence
+	journal *Journal
+
+	// Event ingestion - mux fans events in from every registered
+	// EventSource, tagging each with its source and reconnecting sources
+	// independently on disconnect. See source.go.
+	mux       *Multiplexer
+	muxCancel context.CancelFunc
 	done      chan struct{}
 	closeOnce sync.Once
 }
 
+// maxFeedEvents bounds how many events are kept in memory, for the live
+// tail and for SetJournalPath's startup replay alike - the on-disk journal
+// keeps the full history regardless.
+const maxFeedEvents = 1000
+
 // NewModel creates a new feed TUI model
 func NewModel() *Model {
 	h := help.New()
@@ -87,15 +128,22 @@ func NewModel() *Model {
 		treeViewport: viewport.New(0, 0),
 		feedViewport: viewport.New(0, 0),
 		rigs:         make(map[string]*Rig),
-		events:       make([]Event, 0, 1000),
+		events:       make([]Event, 0, maxFeedEvents),
 		keys:         DefaultKeyMap(),
 		help:         h,
+		wm:           newWindowManager(),
+		zoneMgr:      zone.New(),
+		mux:          newMultiplexer(),
 		done:         make(chan struct{}),
 	}
 }
 
 // Init initializes the model
 func (m *Model) Init() tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.muxCancel = cancel
+	m.mux.Start(ctx)
+
 	return tea.Batch(
 		m.listenForEvents(),
 		tea.SetWindowTitle("GT Feed"),
@@ -108,13 +156,11 @@ type eventMsg Event
 // tickMsg is sent periodically to refresh the view
 type tickMsg time.Time
 
-// listenForEvents returns a command that listens for events
+// listenForEvents returns a command that listens for events merged from
+// every registered EventSource.
 func (m *Model) listenForEvents() tea.Cmd {
-	if m.eventChan == nil {
-		return nil
-	}
 	// Capture channels to avoid race with Model mutations
-	eventChan := m.eventChan
+	eventChan := m.mux.Events()
 	done := m.done
 	return func() tea.Msg {
 		select {
@@ -142,11 +188,23 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if cmd, handled := m.dispatchToActive(msg); handled {
+			return m, cmd
+		}
 		return m.handleKey(msg)
 
+	case beadLoadedMsg:
+		if cmd, handled := m.dispatchToActive(msg); handled {
+			cmds = append(cmds, cmd)
+		}
+
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.wm.SetSize(msg.Width, msg.Height)
 		m.updateViewportSizes()
 
 	case eventMsg:
@@ -169,11 +227,54 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// dispatchToActive forwards msg to the window manager's active subView,
+// if any - closing it when it returns nil (the convention every subView
+// uses to signal "I'm done"), else keeping whatever it returned as the
+// new active view. handled is false when there's no active subView,
+// meaning the caller should fall back to legacy (pre-window-manager)
+// handling of msg itself.
+func (m *Model) dispatchToActive(msg tea.Msg) (cmd tea.Cmd, handled bool) {
+	active := m.wm.Active()
+	if active == nil {
+		return nil, false
+	}
+	next, cmd := active.Update(msg)
+	if next == nil {
+		m.wm.Dismiss()
+		m.updateViewContent()
+	} else {
+		m.wm.Replace(next)
+	}
+	return cmd, true
+}
+
 // handleKey processes key presses
 func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.filterMode {
+		return m.handleFilterKey(msg)
+	}
+
 	switch {
+	case key.Matches(msg, m.keys.Filter):
+		m.filterMode = true
+		return m, nil
+
+	case key.Matches(msg, m.keys.NextMatch):
+		m.jumpToMatch(1)
+		return m, nil
+
+	case key.Matches(msg, m.keys.PrevMatch):
+		m.jumpToMatch(-1)
+		return m, nil
+
 	case key.Matches(msg, m.keys.Quit):
-		m.closeOnce.Do(func() { close(m.done) })
+		m.closeOnce.Do(func() {
+			if m.muxCancel != nil {
+				m.muxCancel()
+			}
+			m.mux.Stop()
+			close(m.done)
+		})
 		return m, tea.Quit
 
 	case key.Matches(msg, m.keys.Help):
@@ -182,9 +283,12 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case key.Matches(msg, m.keys.Tab):
-		if m.focusedPanel == PanelTree {
+		switch m.focusedPanel {
+		case PanelTree:
 			m.focusedPanel = PanelFeed
-		} else {
+		case PanelFeed:
+			m.focusedPanel = PanelTimeline
+		default:
 			m.focusedPanel = PanelTree
 		}
 		return m, nil
@@ -197,9 +301,45 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.focusedPanel = PanelFeed
 		return m, nil
 
+	case key.Matches(msg, m.keys.FocusTimeline):
+		m.focusedPanel = PanelTimeline
+		return m, nil
+
+	case key.Matches(msg, m.keys.TimelinePause):
+		if m.focusedPanel == PanelTimeline {
+			m.timelinePaused = !m.timelinePaused
+			if !m.timelinePaused {
+				m.timelineIdx = len(m.events) - 1
+			}
+			m.updateViewContent()
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keys.TimelineBack):
+		if m.focusedPanel == PanelTimeline {
+			m.scrubTimeline(-1)
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keys.TimelineForward):
+		if m.focusedPanel == PanelTimeline {
+			m.scrubTimeline(1)
+		}
+		return m, nil
+
 	case key.Matches(msg, m.keys.Refresh):
 		m.updateViewContent()
 		return m, nil
+
+	case key.Matches(msg, m.keys.Palette):
+		return m, m.wm.PushModal(StateCommandPalette, newCommandPaletteView(m))
+
+	case key.Matches(msg, m.keys.Inspect):
+		m.inspectFocused()
+		return m, nil
+
+	case key.Matches(msg, m.keys.Open):
+		return m, m.openBead()
 	}
 
 	// Pass to focused viewport
@@ -212,7 +352,121 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// handleFilterKey handles key presses while the user is typing a filter
+// query after pressing '/'. Enter commits the query and returns to normal
+// mode; Esc cancels, clearing whatever was typed. Every other key edits
+// m.filter directly so renderTree/renderFeed's live predicate updates as
+// the user types, with no separate "apply" step.
+func (m *Model) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filterMode = false
+		m.feedMatchIdx = 0
+		m.updateViewContent()
+		return m, nil
+
+	case tea.KeyEsc:
+		m.filterMode = false
+		m.filter = ""
+		m.feedMatchIdx = 0
+		m.updateViewContent()
+		return m, nil
+
+	case tea.KeyBackspace:
+		if m.filter != "" {
+			runes := []rune(m.filter)
+			m.filter = string(runes[:len(runes)-1])
+		}
+		m.updateViewContent()
+		return m, nil
+
+	case tea.KeyRunes, tea.KeySpace:
+		m.filter += msg.String()
+		m.updateViewContent()
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// jumpToMatch moves the feed viewport to the next (dir=1) or previous
+// (dir=-1) event matching the current filter, wrapping around either end
+// of the match set. No-op when the filter is empty or matches nothing.
+func (m *Model) jumpToMatch(dir int) {
+	matches := m.matchingFeedIndices()
+	if len(matches) == 0 {
+		return
+	}
+
+	m.feedMatchIdx = ((m.feedMatchIdx+dir)%len(matches) + len(matches)) % len(matches)
+	m.feedViewport.SetYOffset(matches[m.feedMatchIdx])
+}
+
+// inspectFocused drills into a detail view for whichever panel has focus:
+// the most recently active agent when the tree is focused, or the rig
+// behind the feed's most recent event otherwise. A no-op if there's
+// nothing to inspect yet (empty feed).
+func (m *Model) inspectFocused() {
+	if m.focusedPanel == PanelTree {
+		if agent := m.mostRecentAgent(); agent != nil {
+			m.wm.SetBase(StateAgentDetail, newAgentDetailView(agent, m.agentEvents(agent)))
+		}
+		return
+	}
+
+	if len(m.events) == 0 {
+		return
+	}
+	last := m.events[len(m.events)-1]
+	if rig, ok := m.rigs[last.Rig]; ok {
+		m.wm.SetBase(StateRigDetail, newRigDetailView(rig, m.rigEvents(rig)))
+	}
+}
+
+// openBead drills into the bead inspector for whichever event is in
+// focus - focusedEventTarget's "most recent" heuristic, same one
+// inspectFocused uses, until the tree/feed gain real cursors. The view
+// opens immediately in a loading state; the returned command fetches the
+// bead off the render loop and repaints it on arrival via beadLoadedMsg.
+func (m *Model) openBead() tea.Cmd {
+	return m.openBeadForTarget(m.focusedEventTarget())
+}
+
+// openBeadForTarget drills into the bead inspector for a specific bead ID
+// (e.g. one resolved from a mouse click on an event line), shared with
+// openBead's "most recent" keyboard path.
+func (m *Model) openBeadForTarget(target string) tea.Cmd {
+	if target == "" {
+		return nil
+	}
+	m.wm.SetBase(StateBeadInspector, newBeadInspectorView(target))
+	return m.fetchBeadCmd(target)
+}
+
+// focusedEventTarget returns the bead ID the currently focused panel
+// implies: the most recent agent's last event's target when the tree is
+// focused, else the feed's most recent event's target. Empty if there's
+// nothing to resolve yet.
+func (m *Model) focusedEventTarget() string {
+	if m.focusedPanel == PanelTree {
+		if agent := m.mostRecentAgent(); agent != nil && agent.LastEvent != nil {
+			return agent.LastEvent.Target
+		}
+		return ""
+	}
+	if len(m.events) == 0 {
+		return ""
+	}
+	return m.events[len(m.events)-1].Target
+}
+
 // updateViewportSizes recalculates viewport dimensions
+// updateViewportSizes recalculates viewport dimensions. Click resolution
+// in handleMouse needs no separate scroll-offset math here: zoneMgr.Scan
+// runs against the fully-rendered frame each View() call, and
+// viewport.View() only emits whatever's currently visible, so a zone's
+// recorded position is already relative to the scrolled content, not the
+// full underlying buffer.
 func (m *Model) updateViewportSizes() {
 	// Reserve space: header (1) + borders (4) + status bar (1) + help (1-2)
 	headerHeight := 1
@@ -252,35 +506,52 @@ func (m *Model) updateViewContent() {
 }
 
 // addEvent adds an event and updates the agent tree
-func (m *Model) addEvent(e Event) {
-	// Update agent tree first (always do this for status tracking)
-	if e.Rig != "" {
-		rig, ok := m.rigs[e.Rig]
-		if !ok {
-			rig = &Rig{
-				Name:     e.Rig,
-				Agents:   make(map[string]*Agent),
-				Expanded: true,
-			}
-			m.rigs[e.Rig] = rig
+// applyEventToRigs folds e into rigs' agent-status tracking. Factored out
+// of addEvent so the timeline scrubber (foldAgentSnapshot) can replay the
+// same tree-update logic against a throwaway map instead of the live tree.
+func applyEventToRigs(rigs map[string]*Rig, e Event) {
+	if e.Rig == "" {
+		return
+	}
+
+	rig, ok := rigs[e.Rig]
+	if !ok {
+		rig = &Rig{
+			Name:     e.Rig,
+			Agents:   make(map[string]*Agent),
+			Expanded: true,
 		}
+		rigs[e.Rig] = rig
+	}
 
-		if e.Actor != "" {
-			agent, ok := rig.Agents[e.Actor]
-			if !ok {
-				agent = &Agent{
-					ID:   e.Actor,
-					Name: e.Actor,
-					Role: e.Role,
-					Rig:  e.Rig,
-				}
-				rig.Agents[e.Actor] = agent
+	if e.Actor != "" {
+		agent, ok := rig.Agents[e.Actor]
+		if !ok {
+			agent = &Agent{
+				ID:   e.Actor,
+				Name: e.Actor,
+				Role: e.Role,
+				Rig:  e.Rig,
 			}
-			agent.LastEvent = &e
-			agent.LastUpdate = e.Time
+			rig.Agents[e.Actor] = agent
 		}
+		eCopy := e
+		agent.LastEvent = &eCopy
+		agent.LastUpdate = e.Time
+	}
+}
+
+func (m *Model) addEvent(e Event) {
+	// Persist to the journal regardless of display filtering below - the
+	// on-disk log is the full history, the feed viewport is just today's
+	// curated view of it.
+	if m.journal != nil {
+		_ = m.journal.Append(e)
 	}
 
+	// Update agent tree first (always do this for status tracking)
+	applyEventToRigs(m.rigs, e)
+
 	// Filter out noisy agent session updates from the event feed.
 	// Agent session molecules (like gt-gastown-crew-joe) update frequently
 	// for status tracking. These updates are visible in the agent tree,
@@ -296,20 +567,95 @@ func (m *Model) addEvent(e Event) {
 	// Add to event feed
 	m.events = append(m.events, e)
 
-	// Keep max 1000 events
-	if len(m.events) > 1000 {
-		m.events = m.events[len(m.events)-1000:]
+	// Keep max events
+	if len(m.events) > maxFeedEvents {
+		m.events = m.events[len(m.events)-maxFeedEvents:]
+	}
+
+	if !m.timelinePaused {
+		m.timelineIdx = len(m.events) - 1
 	}
 
 	m.updateViewContent()
 }
 
-// SetEventChannel sets the channel to receive events from
-func (m *Model) SetEventChannel(ch <-chan Event) {
-	m.eventChan = ch
+// scrubTimeline moves the timeline scrubber by delta events, pausing the
+// live tail if it wasn't already paused - stepping implies the user wants
+// to look at a fixed point in history, not keep tailing while they do it.
+func (m *Model) scrubTimeline(delta int) {
+	if len(m.events) == 0 {
+		return
+	}
+	m.timelinePaused = true
+	m.timelineIdx += delta
+	if m.timelineIdx < 0 {
+		m.timelineIdx = 0
+	}
+	if m.timelineIdx > len(m.events)-1 {
+		m.timelineIdx = len(m.events) - 1
+	}
+	m.updateViewContent()
+}
+
+// foldAgentSnapshot reconstructs what the agent tree looked like after
+// folding every event up to and including index upto, without mutating the
+// live tree - this is what the timeline scrubber renders while paused, so
+// scrubbing backward shows reconstructed status rather than the live one.
+func (m *Model) foldAgentSnapshot(upto int) map[string]*Rig {
+	rigs := make(map[string]*Rig)
+	for i := 0; i <= upto && i < len(m.events); i++ {
+		applyEventToRigs(rigs, m.events[i])
+	}
+	return rigs
+}
+
+// SetJournalPath opens (or creates) a rotating event journal at path and
+// replays its existing contents into m.events/m.rigs before wiring future
+// addEvent calls to append to it - so a user who restarts the feed TUI
+// picks up historical context instead of starting from an empty feed.
+func (m *Model) SetJournalPath(path string) error {
+	replayed, err := ReplayJournal(path)
+	if err != nil {
+		return fmt.Errorf("replaying journal: %w", err)
+	}
+
+	for _, e := range replayed {
+		applyEventToRigs(m.rigs, e)
+	}
+	m.events = append(m.events, replayed...)
+	if len(m.events) > maxFeedEvents {
+		m.events = m.events[len(m.events)-maxFeedEvents:]
+	}
+	m.timelineIdx = len(m.events) - 1
+
+	j, err := NewJournal(path)
+	if err != nil {
+		return err
+	}
+	m.journal = j
+
+	m.updateViewContent()
+	return nil
+}
+
+// AddSource registers an EventSource the feed TUI should watch, alongside
+// any others already registered - e.g. one rig's local bead store plus
+// another's remote SSH tail, merged into a single feed. Safe to call
+// before or after Init.
+func (m *Model) AddSource(src EventSource) {
+	m.mux.Add(src)
+}
+
+// SourceStatusLine renders a compact connection-health summary across
+// every registered source, for render() to fold into the header.
+func (m *Model) SourceStatusLine() string {
+	return m.mux.StatusLine()
 }
 
 // View renders the TUI
 func (m *Model) View() string {
-	return m.render()
+	if active := m.wm.Active(); active != nil {
+		return m.zoneMgr.Scan(active.View())
+	}
+	return m.zoneMgr.Scan(m.render())
 }
@@ -0,0 +1,66 @@
+package feed
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Zone ID helpers - render() (elsewhere in the package) wraps each rig
+// header, agent row, and event line with m.zoneMgr.Mark(<id>, content)
+// using these same IDs, so handleMouse can map a click back to the row
+// that produced it via m.zoneMgr.Get(<id>).
+func rigZoneID(rig string) string          { return "rig:" + rig }
+func agentZoneID(rig, agent string) string { return "agent:" + rig + "/" + agent }
+func eventZoneID(idx int) string           { return fmt.Sprintf("event:%d", idx) }
+
+// handleMouse maps a mouse click's coordinates to whichever zone it
+// landed in: a rig header toggles Rig.Expanded, an agent row toggles
+// Agent.Expanded and filters the feed down to that agent, and an event
+// line opens the bead inspector for it. Modals and drill-down views don't
+// expose click zones of their own yet, so clicks are ignored while one is
+// active.
+func (m *Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	if m.wm.Active() != nil {
+		return m, nil
+	}
+
+	for rigName, rig := range m.rigs {
+		if z := m.zoneMgr.Get(rigZoneID(rigName)); z != nil && z.InBounds(msg) {
+			rig.Expanded = !rig.Expanded
+			m.updateViewContent()
+			return m, nil
+		}
+
+		for agentName, agent := range rig.Agents {
+			if z := m.zoneMgr.Get(agentZoneID(rigName, agentName)); z != nil && z.InBounds(msg) {
+				agent.Expanded = !agent.Expanded
+				m.filter = agent.Name
+				m.feedMatchIdx = 0
+				m.updateViewContent()
+				return m, nil
+			}
+		}
+	}
+
+	for i := range m.events {
+		if z := m.zoneMgr.Get(eventZoneID(i)); z != nil && z.InBounds(msg) {
+			return m, m.openBeadForEvent(i)
+		}
+	}
+
+	return m, nil
+}
+
+// openBeadForEvent opens the bead inspector for the event at idx - the
+// mouse-click counterpart to openBead's keyboard "most recent event"
+// heuristic.
+func (m *Model) openBeadForEvent(idx int) tea.Cmd {
+	if idx < 0 || idx >= len(m.events) {
+		return nil
+	}
+	return m.openBeadForTarget(m.events[idx].Target)
+}
@@ -0,0 +1,79 @@
+package feed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// rigDetailView shows one rig's agents and the events that touched it,
+// drilled into from the feed via m.keys.Inspect. Closes on Esc.
+type rigDetailView struct {
+	rig    *Rig
+	events []Event
+	vp     viewport.Model
+}
+
+func newRigDetailView(rig *Rig, events []Event) *rigDetailView {
+	v := &rigDetailView{rig: rig, events: events, vp: viewport.New(0, 0)}
+	v.vp.SetContent(v.renderBody())
+	return v
+}
+
+func (v *rigDetailView) Init() tea.Cmd { return nil }
+
+func (v *rigDetailView) Update(msg tea.Msg) (subView, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyEsc {
+			return nil, nil
+		}
+	}
+	var cmd tea.Cmd
+	v.vp, cmd = v.vp.Update(msg)
+	return v, cmd
+}
+
+func (v *rigDetailView) SetSize(w, h int) {
+	v.vp.Width = w
+	v.vp.Height = h - 2
+}
+
+func (v *rigDetailView) View() string {
+	header := fmt.Sprintf("rig: %s  agents: %d", v.rig.Name, len(v.rig.Agents))
+	footer := "esc: back to feed"
+	return strings.Join([]string{header, v.vp.View(), footer}, "\n")
+}
+
+func (v *rigDetailView) renderBody() string {
+	var names []string
+	for name := range v.rig.Agents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		a := v.rig.Agents[name]
+		fmt.Fprintf(&b, "%-20s %-10s %s\n", a.Name, a.Role, a.Status)
+	}
+	b.WriteString("\nrecent events:\n")
+	for _, e := range v.events {
+		fmt.Fprintf(&b, "%s  %-8s %s\n", e.Time.Format("15:04:05"), e.Type, e.Message)
+	}
+	return b.String()
+}
+
+// rigEvents returns every recorded event for rig, oldest first.
+func (m *Model) rigEvents(rig *Rig) []Event {
+	var out []Event
+	for _, e := range m.events {
+		if e.Rig == rig.Name {
+			out = append(out, e)
+		}
+	}
+	return out
+}
@@ -0,0 +1,330 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventSource is one feed of Events the TUI can watch - the local bead
+// store, a remote SSH tail, an HTTP SSE endpoint, a replayed file, or
+// anything else that can produce a channel of them. Subscribe may be
+// called more than once over a source's lifetime: the Multiplexer calls
+// it again, with backoff, whenever a previous subscription's channel
+// closes or Subscribe itself fails, so a source needs to tolerate being
+// re-subscribed after a disconnect.
+type EventSource interface {
+	Subscribe(ctx context.Context) (<-chan Event, error)
+	Name() string
+	Close() error
+}
+
+// SourceStatus is a source's current connection health, for the header
+// status indicator.
+type SourceStatus int
+
+const (
+	SourceConnecting SourceStatus = iota
+	SourceConnected
+	SourceBackoff
+	SourceClosed
+)
+
+func (s SourceStatus) String() string {
+	switch s {
+	case SourceConnecting:
+		return "connecting"
+	case SourceConnected:
+		return "connected"
+	case SourceBackoff:
+		return "backoff"
+	case SourceClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	sourceInitialBackoff  = 500 * time.Millisecond
+	sourceMaxBackoff      = 30 * time.Second
+	defaultSourceRingSize = 256
+)
+
+// Multiplexer fans events in from any number of EventSources into one
+// merged, source-tagged channel. Each source reconnects independently
+// with exponential backoff (Subscribe failing, or its channel closing,
+// triggers a retry) and is buffered through its own bounded ring, so a
+// burst from one source - or a stall reading the merged channel - can't
+// block that source's producer goroutine or starve the others.
+type Multiplexer struct {
+	mu      sync.Mutex
+	sources []EventSource
+	status  map[string]SourceStatus
+
+	out      chan Event
+	ringSize int
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func newMultiplexer() *Multiplexer {
+	return &Multiplexer{
+		status:   make(map[string]SourceStatus),
+		out:      make(chan Event, defaultSourceRingSize),
+		ringSize: defaultSourceRingSize,
+	}
+}
+
+// Add registers src. If Start has already run, src begins being consumed
+// immediately; otherwise it starts alongside every other registered
+// source when Start is called.
+func (mux *Multiplexer) Add(src EventSource) {
+	mux.mu.Lock()
+	mux.sources = append(mux.sources, src)
+	mux.status[src.Name()] = SourceConnecting
+	ctx := mux.ctx
+	mux.mu.Unlock()
+
+	if ctx != nil {
+		go mux.runSource(ctx, src)
+	}
+}
+
+// Start launches one reconnecting goroutine per registered source,
+// fanning their events into Events(). Safe to call once; ctx cancellation
+// stops every source.
+func (mux *Multiplexer) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	mux.mu.Lock()
+	mux.ctx = ctx
+	mux.cancel = cancel
+	sources := append([]EventSource(nil), mux.sources...)
+	mux.mu.Unlock()
+
+	for _, src := range sources {
+		go mux.runSource(ctx, src)
+	}
+}
+
+// Stop cancels every source's subscription and closes each one.
+func (mux *Multiplexer) Stop() {
+	mux.mu.Lock()
+	cancel := mux.cancel
+	sources := append([]EventSource(nil), mux.sources...)
+	mux.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	for _, src := range sources {
+		_ = src.Close()
+		mux.setStatus(src.Name(), SourceClosed)
+	}
+}
+
+// Events returns the merged, source-tagged event channel.
+func (mux *Multiplexer) Events() <-chan Event {
+	return mux.out
+}
+
+// Status returns a snapshot of every source's current connection health.
+func (mux *Multiplexer) Status() map[string]SourceStatus {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	out := make(map[string]SourceStatus, len(mux.status))
+	for k, v := range mux.status {
+		out[k] = v
+	}
+	return out
+}
+
+// StatusLine renders a compact "name:status  name:status  ..." summary in
+// registration order, for render() to fold into the header.
+func (mux *Multiplexer) StatusLine() string {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	parts := make([]string, 0, len(mux.sources))
+	for _, src := range mux.sources {
+		parts = append(parts, fmt.Sprintf("%s:%s", src.Name(), mux.status[src.Name()]))
+	}
+	return strings.Join(parts, "  ")
+}
+
+func (mux *Multiplexer) setStatus(name string, s SourceStatus) {
+	mux.mu.Lock()
+	mux.status[name] = s
+	mux.mu.Unlock()
+}
+
+// runSource owns one source's lifecycle: subscribe, forward through a
+// bounded ring buffer until the channel closes or ctx is cancelled, then
+// back off and retry. Runs until ctx is done.
+func (mux *Multiplexer) runSource(ctx context.Context, src EventSource) {
+	backoff := sourceInitialBackoff
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		mux.setStatus(src.Name(), SourceConnecting)
+		ch, err := src.Subscribe(ctx)
+		if err != nil {
+			mux.setStatus(src.Name(), SourceBackoff)
+			if !sleepOrDone(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff)
+			continue
+		}
+
+		mux.setStatus(src.Name(), SourceConnected)
+		backoff = sourceInitialBackoff
+		mux.drainSource(ctx, src.Name(), ch)
+
+		mux.setStatus(src.Name(), SourceBackoff)
+		if !sleepOrDone(ctx, backoff) {
+			return
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// drainSource buffers one subscription's events through a bounded ring
+// buffer and forwards them into mux.out, tagging each with src's name.
+// Returns once ch closes or ctx is cancelled.
+func (mux *Multiplexer) drainSource(ctx context.Context, name string, ch <-chan Event) {
+	rb := newRingBuffer(mux.ringSize)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-rb.notify:
+				mux.flushRing(ctx, rb)
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for e := range ch {
+		e.Source = name
+		rb.push(e)
+	}
+
+	// ch closed (this subscription ended) - stop the flush goroutine and
+	// drain whatever it didn't get to, so drainSource returns once this
+	// subscription is actually finished rather than waiting on ctx, which
+	// only fires when the whole Multiplexer shuts down.
+	close(stop)
+	<-done
+	mux.flushRing(ctx, rb)
+}
+
+func (mux *Multiplexer) flushRing(ctx context.Context, rb *ringBuffer) {
+	for _, e := range rb.drain() {
+		select {
+		case mux.out <- e:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func nextBackoff(cur time.Duration) time.Duration {
+	next := cur * 2
+	if next > sourceMaxBackoff {
+		return sourceMaxBackoff
+	}
+	return next
+}
+
+func sleepOrDone(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// ringBuffer is a fixed-capacity FIFO of events that drops the oldest
+// entry once full rather than blocking push - so a burst from a fast
+// source, or a stall reading Multiplexer.Events(), applies backpressure
+// by dropping stale events instead of blocking that source's producer
+// goroutine (and, transitively, every other source sharing the merged
+// output).
+type ringBuffer struct {
+	mu     sync.Mutex
+	buf    []Event
+	cap    int
+	notify chan struct{}
+}
+
+func newRingBuffer(capacity int) *ringBuffer {
+	return &ringBuffer{cap: capacity, notify: make(chan struct{}, 1)}
+}
+
+func (r *ringBuffer) push(e Event) {
+	r.mu.Lock()
+	if len(r.buf) >= r.cap {
+		r.buf = r.buf[1:]
+	}
+	r.buf = append(r.buf, e)
+	r.mu.Unlock()
+
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (r *ringBuffer) drain() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.buf) == 0 {
+		return nil
+	}
+	out := r.buf
+	r.buf = nil
+	return out
+}
+
+// chanEventSource adapts a plain channel of events - e.g. whatever the
+// previous single-channel SetEventChannel API was fed - into an
+// EventSource. Its Subscribe only succeeds once: a bare channel has no
+// way to reconnect once it's closed, so a disconnect here is permanent
+// (the Multiplexer will keep retrying on its own schedule regardless, it
+// just always gets the same "already closed" error back).
+type chanEventSource struct {
+	name string
+	ch   <-chan Event
+	used bool
+}
+
+// NewChanEventSource wraps an existing channel of events as an
+// EventSource, for callers that already have one (e.g. a local listener)
+// rather than something that knows how to (re)dial a connection.
+func NewChanEventSource(name string, ch <-chan Event) EventSource {
+	return &chanEventSource{name: name, ch: ch}
+}
+
+func (s *chanEventSource) Name() string { return s.name }
+
+func (s *chanEventSource) Close() error { return nil }
+
+func (s *chanEventSource) Subscribe(ctx context.Context) (<-chan Event, error) {
+	if s.used {
+		return nil, fmt.Errorf("source %s: channel already consumed, cannot resubscribe", s.name)
+	}
+	s.used = true
+	return s.ch, nil
+}
@@ -0,0 +1,143 @@
+package feed
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// AppState identifies which view currently owns the screen, or - for
+// modals - sits on top of it. Model.Update consults this (via the
+// WindowManager) to decide whether a key press belongs to the base feed
+// view or to whatever is focused above it.
+type AppState int
+
+const (
+	StateFeed AppState = iota
+	StateAgentDetail
+	StateRigDetail
+	StateBeadInspector
+	StateHelp
+	StateFilter
+	StateCommandPalette
+)
+
+// subView is a self-contained view inside the feed TUI: something with
+// its own Init/Update/View, independent of Model's own fields. Drill-down
+// views (agent detail, rig detail) and modals (the command palette) are
+// each one, so adding a new one - e.g. a future bead inspector - doesn't
+// require growing Model's Update/View any further.
+type subView interface {
+	Init() tea.Cmd
+	Update(msg tea.Msg) (subView, tea.Cmd)
+	View() string
+}
+
+// WindowManager owns which subView the window manager is currently
+// showing: an optional base view replacing the feed (a drill-down) plus a
+// stack of modals layered on top of it (command palette today; help and
+// the filter prompt still render through Model's own fields and only
+// report their AppState here for State()'s sake). Popping a modal always
+// returns focus to whatever was beneath it without disturbing that view's
+// own state.
+type WindowManager struct {
+	baseState AppState
+	base      subView
+
+	stack []modalEntry
+
+	width, height int
+}
+
+type modalEntry struct {
+	state AppState
+	view  subView
+}
+
+func newWindowManager() *WindowManager {
+	return &WindowManager{baseState: StateFeed}
+}
+
+// State reports the AppState currently on top: the topmost modal if any
+// are open, else the base view's state (StateFeed when no drill-down is
+// active).
+func (wm *WindowManager) State() AppState {
+	if n := len(wm.stack); n > 0 {
+		return wm.stack[n-1].state
+	}
+	return wm.baseState
+}
+
+// Active returns the subView that should receive the next message: the
+// topmost modal, else the base view, or nil when both the modal stack and
+// the base are empty - meaning the caller should fall back to legacy
+// (pre-window-manager) key handling for the feed view itself.
+func (wm *WindowManager) Active() subView {
+	if n := len(wm.stack); n > 0 {
+		return wm.stack[n-1].view
+	}
+	return wm.base
+}
+
+// SetBase replaces the base view - e.g. drilling from the feed into an
+// agent or rig detail view, or returning to StateFeed by passing nil.
+func (wm *WindowManager) SetBase(state AppState, view subView) {
+	wm.baseState = state
+	wm.base = view
+	if view != nil {
+		wm.propagateSize(view)
+	}
+}
+
+// PushModal opens view on top of whatever is currently active and returns
+// its Init command.
+func (wm *WindowManager) PushModal(state AppState, view subView) tea.Cmd {
+	wm.propagateSize(view)
+	wm.stack = append(wm.stack, modalEntry{state: state, view: view})
+	return view.Init()
+}
+
+// Dismiss closes the topmost modal if one is open, else clears the base
+// view (returning to StateFeed).
+func (wm *WindowManager) Dismiss() {
+	if n := len(wm.stack); n > 0 {
+		wm.stack = wm.stack[:n-1]
+		return
+	}
+	wm.baseState = StateFeed
+	wm.base = nil
+}
+
+// Replace swaps the active subView for next, the value Update returned -
+// most subViews are pointer receivers and mutate in place, but returning
+// the (possibly new) subView keeps this consistent with bubbletea's own
+// Model.Update convention.
+func (wm *WindowManager) Replace(next subView) {
+	if n := len(wm.stack); n > 0 {
+		wm.stack[n-1].view = next
+		return
+	}
+	wm.base = next
+}
+
+// SetSize records the terminal size and propagates it to every view
+// currently on screen - the base view and the full modal stack - so a
+// resize reflows whatever's visible, not just the feed underneath it.
+func (wm *WindowManager) SetSize(w, h int) {
+	wm.width, wm.height = w, h
+	if wm.base != nil {
+		wm.propagateSize(wm.base)
+	}
+	for _, m := range wm.stack {
+		wm.propagateSize(m.view)
+	}
+}
+
+// sizeable is implemented by subViews that need the terminal size outside
+// of a tea.WindowSizeMsg, e.g. ones constructed mid-session by a key
+// handler rather than present at the initial resize.
+type sizeable interface {
+	SetSize(w, h int)
+}
+
+func (wm *WindowManager) propagateSize(v subView) {
+	if s, ok := v.(sizeable); ok {
+		s.SetSize(wm.width, wm.height)
+	}
+}